@@ -0,0 +1,38 @@
+package moslemSalatTimes
+
+import (
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	"github.com/naufalfmm/moslem-salat-times/err"
+	"github.com/naufalfmm/moslem-salat-times/option"
+	"github.com/naufalfmm/moslem-salat-times/schedule"
+)
+
+// Build ends the option chain. It validates the copy of the option for the salats, all of them when none is given,
+// and returns the MoslemSalatTimes on the copy, so the later changes of the option do not leak into it.
+// Like New, the methods still take the option, so pass the validated copy from GetOption to them.
+func Build(opt option.Option, salats ...salatEnum.Salat) (MoslemSalatTimes, error) {
+	if len(salats) == 0 {
+		salats = salatEnum.GetAll()
+	}
+
+	opt = opt.Clone()
+	for _, salat := range salats {
+		if err := opt.ValidateBySalat(salat); err != nil {
+			return nil, err
+		}
+	}
+
+	opt, calcErr := opt.CalculateSunPositions()
+	if calcErr != nil {
+		return nil, calcErr
+	}
+
+	scheduleOpt, ok := opt.(*schedule.Option)
+	if !ok {
+		return nil, err.ErrOptionNotSupported
+	}
+
+	return &schedule.Schedule{
+		Opt: scheduleOpt.ToCommOpt(),
+	}, nil
+}
@@ -0,0 +1,53 @@
+package moslemSalatTimes
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/naufalfmm/angle"
+	mazhabEnum "github.com/naufalfmm/moslem-salat-times/enum/mazhab"
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	twilightEnum "github.com/naufalfmm/moslem-salat-times/enum/twilight"
+	"github.com/naufalfmm/moslem-salat-times/err"
+	"github.com/naufalfmm/moslem-salat-times/schedule"
+)
+
+func TestBuild(t *testing.T) {
+	jakarta := time.FixedZone("0700", 7*60*60)
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, jakarta)
+	opt := newTestOption(-6.2088, 106.8456, jakarta).SetDateRange(date, date)
+
+	built, buildErr := Build(opt)
+	if buildErr != nil {
+		t.Fatalf("Build() error = %v", buildErr)
+	}
+
+	opt.SetDateRange(date.AddDate(0, 0, 1), date.AddDate(0, 0, 1))
+
+	allSalatTimes, calcErr := built.AllTimes(built.GetOption())
+	if calcErr != nil {
+		t.Fatalf("AllTimes() error = %v", calcErr)
+	}
+
+	if len(allSalatTimes) != 1 || allSalatTimes[0].Date.Day() != date.Day() {
+		t.Errorf("AllTimes() = %+v, want the built date %v", allSalatTimes, date)
+	}
+}
+
+func TestBuildMissingLatitude(t *testing.T) {
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	opt := (&schedule.Option{}).
+		SetTwilightConvention(twilightEnum.Astronomical, twilightEnum.Astronomical).
+		SetMazhab(mazhabEnum.Standard).
+		SetDateRange(date, date)
+
+	if _, buildErr := Build(opt); !errors.Is(buildErr, err.ErrLatitudeMissing) {
+		t.Errorf("Build() error = %v, want %v", buildErr, err.ErrLatitudeMissing)
+	}
+
+	opt.SetLatitudeLongitude(angle.NewDegreeFromFloat(-6.2088), angle.NewDegreeFromFloat(106.8456))
+	if _, buildErr := Build(opt, salatEnum.Dhuhr); buildErr != nil {
+		t.Errorf("Build() with the latitude error = %v", buildErr)
+	}
+}
@@ -0,0 +1,77 @@
+package moslemSalatTimes
+
+import (
+	"time"
+
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	"github.com/naufalfmm/moslem-salat-times/err"
+	"github.com/naufalfmm/moslem-salat-times/model"
+	"github.com/naufalfmm/moslem-salat-times/option"
+	"github.com/naufalfmm/moslem-salat-times/schedule"
+)
+
+func allTimesOnDate(opt option.Option, date time.Time) (model.AllSalatTime, error) {
+	allSalatTimes, calcErr := (&schedule.Schedule{}).AllTimes(opt.Clone().SetDateRange(date, date))
+	if calcErr != nil {
+		return model.AllSalatTime{}, calcErr
+	}
+
+	if len(allSalatTimes) == 0 {
+		return model.AllSalatTime{}, err.ErrDateExcluded
+	}
+
+	return allSalatTimes[0], nil
+}
+
+// CompareLocations returns the instant difference of each salat computed by both options on the date, that is the time of b minus the time of a.
+// The positive difference means the salat of b comes later. The salat unavailable on either side is left out.
+func CompareLocations(date time.Time, a, b option.Option) (map[salatEnum.Salat]time.Duration, error) {
+	aAllSalatTime, err := allTimesOnDate(a, date)
+	if err != nil {
+		return nil, err
+	}
+
+	bAllSalatTime, err := allTimesOnDate(b, date)
+	if err != nil {
+		return nil, err
+	}
+
+	bTimes := make(map[salatEnum.Salat]time.Time, len(bAllSalatTime.SalatTimes))
+	for _, salatTime := range bAllSalatTime.SalatTimes {
+		if !salatTime.Unavailable {
+			bTimes[salatTime.Salat] = salatTime.Time
+		}
+	}
+
+	diffs := make(map[salatEnum.Salat]time.Duration, len(aAllSalatTime.SalatTimes))
+	for _, salatTime := range aAllSalatTime.SalatTimes {
+		if bTime, ok := bTimes[salatTime.Salat]; ok && !salatTime.Unavailable {
+			diffs[salatTime.Salat] = bTime.Sub(salatTime.Time)
+		}
+	}
+
+	return diffs, nil
+}
+
+// CompareAgainst returns the instant difference of each salat of the reference timetable from the computed one on its date, that is the computed time minus the reference time.
+// The positive difference means the computed salat comes later. The salat missing or unavailable in the computation is left out.
+func CompareAgainst(opt option.Option, reference map[time.Time]map[salatEnum.Salat]time.Time) (map[time.Time]map[salatEnum.Salat]time.Duration, error) {
+	diffs := make(map[time.Time]map[salatEnum.Salat]time.Duration, len(reference))
+	for date, referenceTimes := range reference {
+		allSalatTime, err := allTimesOnDate(opt, date)
+		if err != nil {
+			return nil, err
+		}
+
+		dateDiffs := map[salatEnum.Salat]time.Duration{}
+		for _, salatTime := range allSalatTime.SalatTimes {
+			if referenceTime, ok := referenceTimes[salatTime.Salat]; ok && !salatTime.Unavailable {
+				dateDiffs[salatTime.Salat] = salatTime.Time.Sub(referenceTime)
+			}
+		}
+
+		diffs[date] = dateDiffs
+	}
+
+	return diffs, nil
+}
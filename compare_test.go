@@ -0,0 +1,51 @@
+package moslemSalatTimes
+
+import (
+	"testing"
+	"time"
+
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+)
+
+func TestCompareLocations(t *testing.T) {
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		latitude  float64
+		longitude float64
+		check     func(diffs map[salatEnum.Salat]time.Duration) bool
+	}{
+		{"same location", -6.2088, 106.8456, func(diffs map[salatEnum.Salat]time.Duration) bool {
+			for _, diff := range diffs {
+				if diff != 0 {
+					return false
+				}
+			}
+
+			return len(diffs) != 0
+		}},
+		{"a degree to the west", -6.2088, 105.8456, func(diffs map[salatEnum.Salat]time.Duration) bool {
+			return (diffs[salatEnum.Dhuhr] - 4*time.Minute).Abs() <= 2*time.Second
+		}},
+		{"polar night", 85., 106.8456, func(diffs map[salatEnum.Salat]time.Duration) bool {
+			_, hasSunrise := diffs[salatEnum.Sunrise]
+			_, hasDhuhr := diffs[salatEnum.Dhuhr]
+
+			return !hasSunrise && hasDhuhr
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diffs, calcErr := CompareLocations(date, newTestOption(-6.2088, 106.8456, time.UTC), newTestOption(tt.latitude, tt.longitude, time.UTC))
+			if calcErr != nil {
+				t.Fatalf("CompareLocations() error = %v", calcErr)
+			}
+
+			if !tt.check(diffs) {
+				t.Errorf("CompareLocations() = %v", diffs)
+			}
+		})
+	}
+}
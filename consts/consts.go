@@ -3,7 +3,19 @@ package consts
 const (
 	DhuhrSlightMarginMinute   = 2.
 	MaghribSlightMarginMinute = 2.
+	ImsakBeforeFajrMinute     = 10.
+
+	MakruhAfterSunriseMinute = 15.
+	MakruhBeforeSunsetMinute = 15.
+	ZawalMarginMinute        = 1.
+	TahajjudNightFraction    = 2. / 3.
 
 	SunriseSunsetAngleFactor = 0.833
+	SunSemidiameterDegree    = 16. / 60.
 	OffsetTimezone           = 3600.
+
+	NearestLatitudeDegree = 48.5
+
+	KaabaLatitude  = 21.4225
+	KaabaLongitude = 39.8262
 )
@@ -0,0 +1,73 @@
+package moslemSalatTimes
+
+import (
+	"sync"
+	"time"
+
+	"github.com/naufalfmm/moslem-salat-times/err"
+	"github.com/naufalfmm/moslem-salat-times/model"
+	"github.com/naufalfmm/moslem-salat-times/option"
+)
+
+// DailyCache keeps the salat times of the current day and recomputes them once the date rolls over
+type DailyCache struct {
+	mss   MoslemSalatTimes
+	opt   option.Option
+	clock func() time.Time
+
+	mu           sync.Mutex
+	date         time.Time
+	allSalatTime model.AllSalatTime
+}
+
+func NewDailyCache(mss MoslemSalatTimes, opt option.Option) *DailyCache {
+	return &DailyCache{
+		mss:   mss,
+		opt:   opt,
+		clock: opt.Now,
+	}
+}
+
+func (d *DailyCache) SetClock(clock func() time.Time) *DailyCache {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.clock = clock
+
+	return d
+}
+
+func (d *DailyCache) Today() (model.AllSalatTime, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.clock()
+	if loc := d.opt.GetTimezone(); loc != nil {
+		now = now.In(loc)
+	}
+
+	if !d.date.IsZero() && isSameDate(d.date, now) {
+		return d.allSalatTime, nil
+	}
+
+	allSalatTimes, calcErr := d.mss.AllTimes(d.opt.Clone().SetDateRange(now, now))
+	if calcErr != nil {
+		return model.AllSalatTime{}, calcErr
+	}
+
+	if len(allSalatTimes) == 0 {
+		return model.AllSalatTime{}, err.ErrDateExcluded
+	}
+
+	d.date = now
+	d.allSalatTime = allSalatTimes[0]
+
+	return d.allSalatTime, nil
+}
+
+func isSameDate(a, b time.Time) bool {
+	aYear, aMonth, aDay := a.Date()
+	bYear, bMonth, bDay := b.Date()
+
+	return aYear == bYear && aMonth == bMonth && aDay == bDay
+}
@@ -0,0 +1,94 @@
+package moslemSalatTimes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/naufalfmm/angle"
+	mazhabEnum "github.com/naufalfmm/moslem-salat-times/enum/mazhab"
+	twilightEnum "github.com/naufalfmm/moslem-salat-times/enum/twilight"
+	"github.com/naufalfmm/moslem-salat-times/model"
+	"github.com/naufalfmm/moslem-salat-times/option"
+	"github.com/naufalfmm/moslem-salat-times/schedule"
+)
+
+func newTestOption(latitude, longitude float64, loc *time.Location) option.Option {
+	return (&schedule.Option{}).
+		SetLatitudeLongitude(angle.NewDegreeFromFloat(latitude), angle.NewDegreeFromFloat(longitude)).
+		SetTimezone(loc).
+		SetTwilightConvention(twilightEnum.Astronomical, twilightEnum.Astronomical).
+		SetMazhab(mazhabEnum.Standard)
+}
+
+type countingSchedule struct {
+	MoslemSalatTimes
+	allTimesCalls int
+}
+
+func (c *countingSchedule) AllTimes(opt option.Option) (model.PeriodicAllSalatTime, error) {
+	c.allTimesCalls++
+
+	return c.MoslemSalatTimes.AllTimes(opt)
+}
+
+func TestDailyCacheRollover(t *testing.T) {
+	jakarta := time.FixedZone("0700", 7*60*60)
+	now := time.Date(2024, time.March, 1, 23, 58, 0, 0, jakarta)
+
+	mss := &countingSchedule{MoslemSalatTimes: &schedule.Schedule{}}
+	cache := NewDailyCache(mss, newTestOption(-6.2, 106.8167, jakarta)).SetClock(func() time.Time { return now })
+
+	steps := []struct {
+		advance time.Duration
+		date    time.Time
+		calls   int
+	}{
+		{0, time.Date(2024, time.March, 1, 0, 0, 0, 0, jakarta), 1},
+		{time.Minute, time.Date(2024, time.March, 1, 0, 0, 0, 0, jakarta), 1},
+		{2 * time.Minute, time.Date(2024, time.March, 2, 0, 0, 0, 0, jakarta), 2},
+		{time.Minute, time.Date(2024, time.March, 2, 0, 0, 0, 0, jakarta), 2},
+		{12 * time.Hour, time.Date(2024, time.March, 2, 0, 0, 0, 0, jakarta), 2},
+	}
+
+	for i, step := range steps {
+		now = now.Add(step.advance)
+
+		allSalatTime, err := cache.Today()
+		if err != nil {
+			t.Fatalf("step %d: Today() error = %v", i, err)
+		}
+
+		if year, month, day := allSalatTime.Date.Date(); year != step.date.Year() || month != step.date.Month() || day != step.date.Day() {
+			t.Errorf("step %d at %v: Today() date = %v, want %v", i, now, allSalatTime.Date, step.date)
+		}
+
+		if mss.allTimesCalls != step.calls {
+			t.Errorf("step %d at %v: AllTimes() calls = %d, want %d", i, now, mss.allTimesCalls, step.calls)
+		}
+	}
+}
+
+func TestDailyCacheRolloverInTimezone(t *testing.T) {
+	jakarta := time.FixedZone("0700", 7*60*60)
+
+	// an hour later, 17:30 UTC is already past the midnight of jakarta
+	now := time.Date(2024, time.March, 1, 16, 30, 0, 0, time.UTC)
+
+	mss := &countingSchedule{MoslemSalatTimes: &schedule.Schedule{}}
+	cache := NewDailyCache(mss, newTestOption(-6.2, 106.8167, jakarta)).SetClock(func() time.Time { return now })
+
+	if _, err := cache.Today(); err != nil {
+		t.Fatalf("Today() error = %v", err)
+	}
+
+	now = now.Add(time.Hour)
+
+	allSalatTime, err := cache.Today()
+	if err != nil {
+		t.Fatalf("Today() error = %v", err)
+	}
+
+	if allSalatTime.Date.Day() != 2 || mss.allTimesCalls != 2 {
+		t.Errorf("Today() date = %v after %d calls, want march 2 after 2 calls", allSalatTime.Date, mss.allTimesCalls)
+	}
+}
@@ -0,0 +1,135 @@
+package accuracyModeEnum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/naufalfmm/moslem-salat-times/err"
+)
+
+type (
+	// AccuracyModeClass .
+	AccuracyModeClass struct {
+		Code string `json:"code"`
+		Name string `json:"name"`
+	}
+
+	// AccuracyMode .
+	AccuracyMode int
+)
+
+const (
+	// Precise computes the sun position by the full solar coordinates. It is the default.
+	Precise AccuracyMode = iota + 1
+	// Fast computes the sun position in float64 without the minor terms, within seconds of Precise
+	Fast
+)
+
+var (
+	accuracyModeConsts = []AccuracyModeClass{
+		{"precise", "Precise"},
+		{"fast", "Fast"},
+	}
+)
+
+// Code .
+func (c AccuracyMode) Code() string {
+	if c < 1 || int(c) > len(accuracyModeConsts) {
+		return ""
+	}
+	return accuracyModeConsts[c-1].Code
+}
+
+// Name .
+func (c AccuracyMode) Name() string {
+	if c < 1 || int(c) > len(accuracyModeConsts) {
+		return ""
+	}
+	return accuracyModeConsts[c-1].Name
+}
+
+// UnmarshalParam parses value from the client (handled by gorm)
+func (c *AccuracyMode) UnmarshalParam(src string) error {
+	index := findIndex(src, func(c AccuracyModeClass) string {
+		return c.Code
+	})
+
+	if index == 0 {
+		return err.ErrUnknownConstant
+	}
+
+	*c = AccuracyMode(index)
+	return nil
+}
+
+// MarshalJSON presents value to the client
+func (c AccuracyMode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Code())
+}
+
+// UnmarshalJSON parses value from the client
+func (c *AccuracyMode) UnmarshalJSON(val []byte) error {
+	var rawVal string
+	if err := json.Unmarshal(val, &rawVal); err != nil {
+		return err
+	}
+
+	index := findIndex(rawVal, func(c AccuracyModeClass) string {
+		return c.Code
+	})
+
+	if index == 0 {
+		return err.ErrUnknownConstant
+	}
+
+	*c = AccuracyMode(index)
+	return nil
+}
+
+// Scan retrieves value from the DB
+func (c *AccuracyMode) Scan(val interface{}) error {
+	rawVal, ok := val.([]byte)
+	if !ok {
+		return err.ErrConstantParsing
+	}
+	dbVal := string(rawVal)
+
+	index := findIndex(dbVal, func(c AccuracyModeClass) string {
+		return c.Code
+	})
+
+	if index == 0 {
+		return err.ErrUnknownConstant
+	}
+
+	*c = AccuracyMode(index)
+	return nil
+}
+
+// Value encodes value to the DB
+func (c AccuracyMode) Value() (driver.Value, error) {
+	return string(c.Code()), nil
+}
+
+func findIndex(code string, selector func(c AccuracyModeClass) string) int {
+	for i, v := range accuracyModeConsts {
+		if selector(v) == code {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// AsCompleteConstants presents constants as their complete object form
+func AsCompleteConstants() []AccuracyModeClass {
+	list := make([]AccuracyModeClass, len(accuracyModeConsts))
+	copy(list, accuracyModeConsts)
+	return list
+}
+
+func GetAll() []AccuracyMode {
+	return []AccuracyMode{
+		Precise,
+		Fast,
+	}
+}
@@ -0,0 +1,128 @@
+package dhuhrDefinitionEnum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/naufalfmm/moslem-salat-times/err"
+)
+
+type (
+	// DhuhrDefinitionClass .
+	DhuhrDefinitionClass struct {
+		Code string `json:"code"`
+		Name string `json:"name"`
+	}
+
+	// DhuhrDefinition .
+	DhuhrDefinition int
+)
+
+const (
+	// Transit .
+	Transit DhuhrDefinition = iota + 1
+	// DeclinePlusMargin .
+	DeclinePlusMargin
+)
+
+var (
+	dhuhrDefinitionConsts = []DhuhrDefinitionClass{
+		{"transit", "Transit"},
+		{"declinePlusMargin", "Decline Plus Margin"},
+	}
+)
+
+// Code .
+func (c DhuhrDefinition) Code() string {
+	if c < 1 || int(c) > len(dhuhrDefinitionConsts) {
+		return ""
+	}
+	return dhuhrDefinitionConsts[c-1].Code
+}
+
+// Name .
+func (c DhuhrDefinition) Name() string {
+	if c < 1 || int(c) > len(dhuhrDefinitionConsts) {
+		return ""
+	}
+	return dhuhrDefinitionConsts[c-1].Name
+}
+
+// UnmarshalParam parses value from the client (handled by gorm)
+func (c *DhuhrDefinition) UnmarshalParam(src string) error {
+	index := findIndex(src, func(c DhuhrDefinitionClass) string {
+		return c.Code
+	})
+
+	if index == 0 {
+		return err.ErrUnknownConstant
+	}
+
+	*c = DhuhrDefinition(index)
+	return nil
+}
+
+// MarshalJSON presents value to the client
+func (c DhuhrDefinition) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Code())
+}
+
+// UnmarshalJSON parses value from the client
+func (c *DhuhrDefinition) UnmarshalJSON(val []byte) error {
+	var rawVal string
+	if err := json.Unmarshal(val, &rawVal); err != nil {
+		return err
+	}
+
+	index := findIndex(rawVal, func(c DhuhrDefinitionClass) string {
+		return c.Code
+	})
+
+	if index == 0 {
+		return err.ErrUnknownConstant
+	}
+
+	*c = DhuhrDefinition(index)
+	return nil
+}
+
+// Scan retrieves value from the DB
+func (c *DhuhrDefinition) Scan(val interface{}) error {
+	rawVal, ok := val.([]byte)
+	if !ok {
+		return err.ErrConstantParsing
+	}
+	dbVal := string(rawVal)
+
+	index := findIndex(dbVal, func(c DhuhrDefinitionClass) string {
+		return c.Code
+	})
+
+	if index == 0 {
+		return err.ErrUnknownConstant
+	}
+
+	*c = DhuhrDefinition(index)
+	return nil
+}
+
+// Value encodes value to the DB
+func (c DhuhrDefinition) Value() (driver.Value, error) {
+	return string(c.Code()), nil
+}
+
+func findIndex(code string, selector func(c DhuhrDefinitionClass) string) int {
+	for i, v := range dhuhrDefinitionConsts {
+		if selector(v) == code {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// AsCompleteConstants presents constants as their complete object form
+func AsCompleteConstants() []DhuhrDefinitionClass {
+	list := make([]DhuhrDefinitionClass, len(dhuhrDefinitionConsts))
+	copy(list, dhuhrDefinitionConsts)
+	return list
+}
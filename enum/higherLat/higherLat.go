@@ -27,6 +27,8 @@ const (
 	AngleBased
 	// None .
 	None
+	// NearestLatitude .
+	NearestLatitude
 )
 
 var (
@@ -35,6 +37,7 @@ var (
 		{"oneSeventh", "OneSeventh"},
 		{"angleBased", "AngleBased"},
 		{"none", "None"},
+		{"nearestLatitude", "NearestLatitude"},
 	}
 )
 
@@ -138,5 +141,6 @@ func GetAll() []HigherLat {
 		NightMiddle,
 		OneSeventh,
 		AngleBased,
+		NearestLatitude,
 	}
 }
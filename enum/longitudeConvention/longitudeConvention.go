@@ -0,0 +1,137 @@
+package longitudeConventionEnum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/naufalfmm/moslem-salat-times/err"
+)
+
+type (
+	// LongitudeConventionClass .
+	LongitudeConventionClass struct {
+		Code     string  `json:"code"`
+		Name     string  `json:"name"`
+		EastSign float64 `json:"eastSign"`
+	}
+
+	// LongitudeConvention .
+	LongitudeConvention int
+)
+
+const (
+	// EastPositive .
+	EastPositive LongitudeConvention = iota + 1
+	// WestPositive .
+	WestPositive
+)
+
+var (
+	longitudeConventionConsts = []LongitudeConventionClass{
+		{"eastPositive", "East Positive", 1},
+		{"westPositive", "West Positive", -1},
+	}
+)
+
+// Code .
+func (c LongitudeConvention) Code() string {
+	if c < 1 || int(c) > len(longitudeConventionConsts) {
+		return ""
+	}
+	return longitudeConventionConsts[c-1].Code
+}
+
+// Name .
+func (c LongitudeConvention) Name() string {
+	if c < 1 || int(c) > len(longitudeConventionConsts) {
+		return ""
+	}
+	return longitudeConventionConsts[c-1].Name
+}
+
+// EastSign is the sign of the east longitude, the east positive by default
+func (c LongitudeConvention) EastSign() float64 {
+	if c < 1 || int(c) > len(longitudeConventionConsts) {
+		return 1
+	}
+	return longitudeConventionConsts[c-1].EastSign
+}
+
+// UnmarshalParam parses value from the client (handled by gorm)
+func (c *LongitudeConvention) UnmarshalParam(src string) error {
+	index := findIndex(src, func(c LongitudeConventionClass) string {
+		return c.Code
+	})
+
+	if index == 0 {
+		return err.ErrUnknownConstant
+	}
+
+	*c = LongitudeConvention(index)
+	return nil
+}
+
+// MarshalJSON presents value to the client
+func (c LongitudeConvention) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Code())
+}
+
+// UnmarshalJSON parses value from the client
+func (c *LongitudeConvention) UnmarshalJSON(val []byte) error {
+	var rawVal string
+	if err := json.Unmarshal(val, &rawVal); err != nil {
+		return err
+	}
+
+	index := findIndex(rawVal, func(c LongitudeConventionClass) string {
+		return c.Code
+	})
+
+	if index == 0 {
+		return err.ErrUnknownConstant
+	}
+
+	*c = LongitudeConvention(index)
+	return nil
+}
+
+// Scan retrieves value from the DB
+func (c *LongitudeConvention) Scan(val interface{}) error {
+	rawVal, ok := val.([]byte)
+	if !ok {
+		return err.ErrConstantParsing
+	}
+	dbVal := string(rawVal)
+
+	index := findIndex(dbVal, func(c LongitudeConventionClass) string {
+		return c.Code
+	})
+
+	if index == 0 {
+		return err.ErrUnknownConstant
+	}
+
+	*c = LongitudeConvention(index)
+	return nil
+}
+
+// Value encodes value to the DB
+func (c LongitudeConvention) Value() (driver.Value, error) {
+	return string(c.Code()), nil
+}
+
+func findIndex(code string, selector func(c LongitudeConventionClass) string) int {
+	for i, v := range longitudeConventionConsts {
+		if selector(v) == code {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// AsCompleteConstants presents constants as their complete object form
+func AsCompleteConstants() []LongitudeConventionClass {
+	list := make([]LongitudeConventionClass, len(longitudeConventionConsts))
+	copy(list, longitudeConventionConsts)
+	return list
+}
@@ -139,6 +139,15 @@ func (c Periodical) GetDateRange(date time.Time) (time.Time, time.Time) {
 	return date, date
 }
 
+// GetDateRangeByWeekStart snaps the weekly range to start on the week start day
+func (c Periodical) GetDateRangeByWeekStart(date time.Time, weekStart time.Weekday) (time.Time, time.Time) {
+	if c == Weekly {
+		date = date.AddDate(0, 0, -((int(date.Weekday()) - int(weekStart) + 7) % 7))
+	}
+
+	return c.GetDateRange(date)
+}
+
 func findIndex(code string, selector func(c PeriodicalClass) string) int {
 	for i, v := range periodicalConsts {
 		if selector(v) == code {
@@ -0,0 +1,131 @@
+package shafaqEnum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/naufalfmm/moslem-salat-times/err"
+)
+
+type (
+	// ShafaqClass .
+	ShafaqClass struct {
+		Code string `json:"code"`
+		Name string `json:"name"`
+	}
+
+	// Shafaq .
+	Shafaq int
+)
+
+const (
+	// General .
+	General Shafaq = iota + 1
+	// Ahmar .
+	Ahmar
+	// Abyad .
+	Abyad
+)
+
+var (
+	shafaqConsts = []ShafaqClass{
+		{"general", "General"},
+		{"ahmar", "Ahmar"},
+		{"abyad", "Abyad"},
+	}
+)
+
+// Code .
+func (c Shafaq) Code() string {
+	if c < 1 || int(c) > len(shafaqConsts) {
+		return ""
+	}
+	return shafaqConsts[c-1].Code
+}
+
+// Name .
+func (c Shafaq) Name() string {
+	if c < 1 || int(c) > len(shafaqConsts) {
+		return ""
+	}
+	return shafaqConsts[c-1].Name
+}
+
+// UnmarshalParam parses value from the client (handled by gorm)
+func (c *Shafaq) UnmarshalParam(src string) error {
+	index := findIndex(src, func(c ShafaqClass) string {
+		return c.Code
+	})
+
+	if index == 0 {
+		return err.ErrUnknownConstant
+	}
+
+	*c = Shafaq(index)
+	return nil
+}
+
+// MarshalJSON presents value to the client
+func (c Shafaq) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Code())
+}
+
+// UnmarshalJSON parses value from the client
+func (c *Shafaq) UnmarshalJSON(val []byte) error {
+	var rawVal string
+	if err := json.Unmarshal(val, &rawVal); err != nil {
+		return err
+	}
+
+	index := findIndex(rawVal, func(c ShafaqClass) string {
+		return c.Code
+	})
+
+	if index == 0 {
+		return err.ErrUnknownConstant
+	}
+
+	*c = Shafaq(index)
+	return nil
+}
+
+// Scan retrieves value from the DB
+func (c *Shafaq) Scan(val interface{}) error {
+	rawVal, ok := val.([]byte)
+	if !ok {
+		return err.ErrConstantParsing
+	}
+	dbVal := string(rawVal)
+
+	index := findIndex(dbVal, func(c ShafaqClass) string {
+		return c.Code
+	})
+
+	if index == 0 {
+		return err.ErrUnknownConstant
+	}
+
+	*c = Shafaq(index)
+	return nil
+}
+
+// Value encodes value to the DB
+func (c Shafaq) Value() (driver.Value, error) {
+	return string(c.Code()), nil
+}
+
+func findIndex(code string, selector func(c ShafaqClass) string) int {
+	for i, v := range shafaqConsts {
+		if selector(v) == code {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// AsCompleteConstants presents constants as their complete object form
+func AsCompleteConstants() []ShafaqClass {
+	list := make([]ShafaqClass, len(shafaqConsts))
+	copy(list, shafaqConsts)
+	return list
+}
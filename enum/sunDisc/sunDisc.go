@@ -0,0 +1,140 @@
+package sunDiscEnum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/naufalfmm/moslem-salat-times/err"
+)
+
+type (
+	// SunDiscClass .
+	SunDiscClass struct {
+		Code          string  `json:"code"`
+		Name          string  `json:"name"`
+		Semidiameters float64 `json:"semidiameters"`
+	}
+
+	// SunDisc .
+	SunDisc int
+)
+
+const (
+	// UpperLimb .
+	UpperLimb SunDisc = iota + 1
+	// Center .
+	Center
+	// LowerLimb .
+	LowerLimb
+)
+
+var (
+	sunDiscConsts = []SunDiscClass{
+		{"upperLimb", "Upper Limb", 0},
+		{"center", "Center", 1},
+		{"lowerLimb", "Lower Limb", 2},
+	}
+)
+
+// Code .
+func (c SunDisc) Code() string {
+	if c < 1 || int(c) > len(sunDiscConsts) {
+		return ""
+	}
+	return sunDiscConsts[c-1].Code
+}
+
+// Name .
+func (c SunDisc) Name() string {
+	if c < 1 || int(c) > len(sunDiscConsts) {
+		return ""
+	}
+	return sunDiscConsts[c-1].Name
+}
+
+// Semidiameters .
+func (c SunDisc) Semidiameters() float64 {
+	if c < 1 || int(c) > len(sunDiscConsts) {
+		return 0
+	}
+	return sunDiscConsts[c-1].Semidiameters
+}
+
+// UnmarshalParam parses value from the client (handled by gorm)
+func (c *SunDisc) UnmarshalParam(src string) error {
+	index := findIndex(src, func(c SunDiscClass) string {
+		return c.Code
+	})
+
+	if index == 0 {
+		return err.ErrUnknownConstant
+	}
+
+	*c = SunDisc(index)
+	return nil
+}
+
+// MarshalJSON presents value to the client
+func (c SunDisc) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Code())
+}
+
+// UnmarshalJSON parses value from the client
+func (c *SunDisc) UnmarshalJSON(val []byte) error {
+	var rawVal string
+	if err := json.Unmarshal(val, &rawVal); err != nil {
+		return err
+	}
+
+	index := findIndex(rawVal, func(c SunDiscClass) string {
+		return c.Code
+	})
+
+	if index == 0 {
+		return err.ErrUnknownConstant
+	}
+
+	*c = SunDisc(index)
+	return nil
+}
+
+// Scan retrieves value from the DB
+func (c *SunDisc) Scan(val interface{}) error {
+	rawVal, ok := val.([]byte)
+	if !ok {
+		return err.ErrConstantParsing
+	}
+	dbVal := string(rawVal)
+
+	index := findIndex(dbVal, func(c SunDiscClass) string {
+		return c.Code
+	})
+
+	if index == 0 {
+		return err.ErrUnknownConstant
+	}
+
+	*c = SunDisc(index)
+	return nil
+}
+
+// Value encodes value to the DB
+func (c SunDisc) Value() (driver.Value, error) {
+	return string(c.Code()), nil
+}
+
+func findIndex(code string, selector func(c SunDiscClass) string) int {
+	for i, v := range sunDiscConsts {
+		if selector(v) == code {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// AsCompleteConstants presents constants as their complete object form
+func AsCompleteConstants() []SunDiscClass {
+	list := make([]SunDiscClass, len(sunDiscConsts))
+	copy(list, sunDiscConsts)
+	return list
+}
@@ -17,10 +17,11 @@ type (
 
 	// SunZenithClass .
 	SunZenithClass struct {
-		Code string      `json:"code"`
-		Name string      `json:"name"`
-		Fajr angle.Angle `json:"fajr"`
-		Isha IshaZenith  `json:"isha"`
+		Code    string      `json:"code"`
+		Name    string      `json:"name"`
+		Fajr    angle.Angle `json:"fajr"`
+		Isha    IshaZenith  `json:"isha"`
+		Maghrib angle.Angle `json:"maghrib"`
 	}
 
 	// SunZenith .
@@ -50,21 +51,33 @@ const (
 	DIYANET
 	// UOIF .
 	UOIF
+	// DUBAI .
+	DUBAI
+	// QATAR .
+	QATAR
+	// KUWAIT .
+	KUWAIT
+	// TEHRAN .
+	TEHRAN
 )
 
 var (
 	sunZenithConsts = []SunZenithClass{
-		{"KEMENAG", "Kementerian Agama Republik Indonesia", angle.NewDegreeFromFloat(20), IshaZenith{angle.NewDegreeFromFloat(18), Standard}},
-		{"ESA", "Egyptian General Authority Survey", angle.NewDegreeFromFloat(19.5), IshaZenith{angle.NewDegreeFromFloat(17.5), Standard}},
-		{"ISNA", "Islamic Society of North America", angle.NewDegreeFromFloat(15), IshaZenith{angle.NewDegreeFromFloat(15), Standard}},
-		{"MCW", "Moonsighting Committee Worldwide", angle.NewDegreeFromFloat(18), IshaZenith{angle.NewDegreeFromFloat(18), Standard}},
-		{"MWL", "Muslim World League", angle.NewDegreeFromFloat(18), IshaZenith{angle.NewDegreeFromFloat(17), Standard}},
-		{"UAU", "Umm Al-Qura University", angle.NewDegreeFromFloat(18.5), IshaZenith{angle.NewFromDegreeMinuteSecond(1., 30., consts.DecimalZero), AfterMagrib}},
-		{"UIS", "University of Islamic Sciences, Karachi", angle.NewDegreeFromFloat(18), IshaZenith{angle.NewDegreeFromFloat(18), Standard}},
-		{"JAKIM", "Jabatan Kemajuan Islam Malaysia", angle.NewDegreeFromFloat(18), IshaZenith{angle.NewDegreeFromFloat(18), Standard}},
-		{"MUIS", "Majlis Ugama Islam Singapura", angle.NewDegreeFromFloat(20), IshaZenith{angle.NewDegreeFromFloat(18), Standard}},
-		{"DIYANET", "Directorate of Religious Affairs", angle.NewDegreeFromFloat(18), IshaZenith{angle.NewDegreeFromFloat(17), Standard}},
-		{"UOIF", "Union of Islamic Organisations of France", angle.NewDegreeFromFloat(12), IshaZenith{angle.NewDegreeFromFloat(12), Standard}},
+		{"KEMENAG", "Kementerian Agama Republik Indonesia", angle.NewDegreeFromFloat(20), IshaZenith{angle.NewDegreeFromFloat(18), Standard}, angle.Zero},
+		{"ESA", "Egyptian General Authority Survey", angle.NewDegreeFromFloat(19.5), IshaZenith{angle.NewDegreeFromFloat(17.5), Standard}, angle.Zero},
+		{"ISNA", "Islamic Society of North America", angle.NewDegreeFromFloat(15), IshaZenith{angle.NewDegreeFromFloat(15), Standard}, angle.Zero},
+		{"MCW", "Moonsighting Committee Worldwide", angle.NewDegreeFromFloat(18), IshaZenith{angle.NewDegreeFromFloat(18), Standard}, angle.Zero},
+		{"MWL", "Muslim World League", angle.NewDegreeFromFloat(18), IshaZenith{angle.NewDegreeFromFloat(17), Standard}, angle.Zero},
+		{"UAU", "Umm Al-Qura University", angle.NewDegreeFromFloat(18.5), IshaZenith{angle.NewFromDegreeMinuteSecond(1., 30., consts.DecimalZero), AfterMagrib}, angle.Zero},
+		{"UIS", "University of Islamic Sciences, Karachi", angle.NewDegreeFromFloat(18), IshaZenith{angle.NewDegreeFromFloat(18), Standard}, angle.Zero},
+		{"JAKIM", "Jabatan Kemajuan Islam Malaysia", angle.NewDegreeFromFloat(18), IshaZenith{angle.NewDegreeFromFloat(18), Standard}, angle.Zero},
+		{"MUIS", "Majlis Ugama Islam Singapura", angle.NewDegreeFromFloat(20), IshaZenith{angle.NewDegreeFromFloat(18), Standard}, angle.Zero},
+		{"DIYANET", "Directorate of Religious Affairs", angle.NewDegreeFromFloat(18), IshaZenith{angle.NewDegreeFromFloat(17), Standard}, angle.Zero},
+		{"UOIF", "Union of Islamic Organisations of France", angle.NewDegreeFromFloat(12), IshaZenith{angle.NewDegreeFromFloat(12), Standard}, angle.Zero},
+		{"DUBAI", "General Authority of Islamic Affairs and Endowments, Dubai", angle.NewDegreeFromFloat(18.2), IshaZenith{angle.NewDegreeFromFloat(18.2), Standard}, angle.Zero},
+		{"QATAR", "Ministry of Awqaf and Islamic Affairs, Qatar", angle.NewDegreeFromFloat(18), IshaZenith{angle.NewFromDegreeMinuteSecond(1., 30., consts.DecimalZero), AfterMagrib}, angle.Zero},
+		{"KUWAIT", "Ministry of Awqaf and Islamic Affairs, Kuwait", angle.NewDegreeFromFloat(18), IshaZenith{angle.NewDegreeFromFloat(17.5), Standard}, angle.Zero},
+		{"TEHRAN", "Institute of Geophysics, University of Tehran", angle.NewDegreeFromFloat(17.7), IshaZenith{angle.NewDegreeFromFloat(14), Standard}, angle.NewDegreeFromFloat(4.5)},
 	}
 )
 
@@ -99,6 +112,14 @@ func (c SunZenith) IshaZenith() IshaZenith {
 	return sunZenithConsts[c-1].Isha
 }
 
+// MaghribZenith .
+func (c SunZenith) MaghribZenith() angle.Angle {
+	if c < 1 || int(c) > len(sunZenithConsts) {
+		return angle.Zero
+	}
+	return sunZenithConsts[c-1].Maghrib
+}
+
 // UnmarshalParam parses value from the client (handled by gorm)
 func (c *SunZenith) UnmarshalParam(src string) error {
 	index := findIndex(src, func(c SunZenithClass) string {
@@ -0,0 +1,140 @@
+package twilightEnum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/naufalfmm/moslem-salat-times/err"
+)
+
+type (
+	// TwilightClass .
+	TwilightClass struct {
+		Code       string  `json:"code"`
+		Name       string  `json:"name"`
+		Depression float64 `json:"depression"`
+	}
+
+	// Twilight .
+	Twilight int
+)
+
+const (
+	// Civil .
+	Civil Twilight = iota + 1
+	// Nautical .
+	Nautical
+	// Astronomical .
+	Astronomical
+)
+
+var (
+	twilightConsts = []TwilightClass{
+		{"civil", "Civil", 6},
+		{"nautical", "Nautical", 12},
+		{"astronomical", "Astronomical", 18},
+	}
+)
+
+// Code .
+func (c Twilight) Code() string {
+	if c < 1 || int(c) > len(twilightConsts) {
+		return ""
+	}
+	return twilightConsts[c-1].Code
+}
+
+// Name .
+func (c Twilight) Name() string {
+	if c < 1 || int(c) > len(twilightConsts) {
+		return ""
+	}
+	return twilightConsts[c-1].Name
+}
+
+// Depression .
+func (c Twilight) Depression() float64 {
+	if c < 1 || int(c) > len(twilightConsts) {
+		return 0
+	}
+	return twilightConsts[c-1].Depression
+}
+
+// UnmarshalParam parses value from the client (handled by gorm)
+func (c *Twilight) UnmarshalParam(src string) error {
+	index := findIndex(src, func(c TwilightClass) string {
+		return c.Code
+	})
+
+	if index == 0 {
+		return err.ErrUnknownConstant
+	}
+
+	*c = Twilight(index)
+	return nil
+}
+
+// MarshalJSON presents value to the client
+func (c Twilight) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Code())
+}
+
+// UnmarshalJSON parses value from the client
+func (c *Twilight) UnmarshalJSON(val []byte) error {
+	var rawVal string
+	if err := json.Unmarshal(val, &rawVal); err != nil {
+		return err
+	}
+
+	index := findIndex(rawVal, func(c TwilightClass) string {
+		return c.Code
+	})
+
+	if index == 0 {
+		return err.ErrUnknownConstant
+	}
+
+	*c = Twilight(index)
+	return nil
+}
+
+// Scan retrieves value from the DB
+func (c *Twilight) Scan(val interface{}) error {
+	rawVal, ok := val.([]byte)
+	if !ok {
+		return err.ErrConstantParsing
+	}
+	dbVal := string(rawVal)
+
+	index := findIndex(dbVal, func(c TwilightClass) string {
+		return c.Code
+	})
+
+	if index == 0 {
+		return err.ErrUnknownConstant
+	}
+
+	*c = Twilight(index)
+	return nil
+}
+
+// Value encodes value to the DB
+func (c Twilight) Value() (driver.Value, error) {
+	return string(c.Code()), nil
+}
+
+func findIndex(code string, selector func(c TwilightClass) string) int {
+	for i, v := range twilightConsts {
+		if selector(v) == code {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// AsCompleteConstants presents constants as their complete object form
+func AsCompleteConstants() []TwilightClass {
+	list := make([]TwilightClass, len(twilightConsts))
+	copy(list, twilightConsts)
+	return list
+}
@@ -6,10 +6,33 @@ var (
 	ErrUnknownConstant   = errors.New("unknown constant")
 	ErrConstantParsing   = errors.New("expected string for the constant")
 	ErrDateMissing       = errors.New("date missing")
+	ErrDateExcluded      = errors.New("date excluded")
 	ErrFajrZenithMissing = errors.New("fajr zenith angle missing")
 	ErrIshaZenithMissing = errors.New("isha zenith angle missing")
 	ErrTimezoneMissing   = errors.New("timezone missing")
 	ErrLatitudeMissing   = errors.New("latitude missing")
 	ErrLongitudeMissing  = errors.New("longitude missing")
 	ErrMazhabMissing     = errors.New("mazhab missing")
+
+	ErrDaylightUndefined  = errors.New("daylight length undefined")
+	ErrFajrNeverValid     = errors.New("fajr zenith angle is never reached in the year")
+	ErrTwilightUndefined  = errors.New("twilight boundary undefined")
+	ErrSalatUndefined     = errors.New("salat time undefined")
+	ErrSalatNotSupported  = errors.New("salat not supported")
+	ErrOptionNotSupported = errors.New("option not supported")
+	ErrInvalidSamples     = errors.New("invalid number of samples")
+	ErrInvalidStep        = errors.New("invalid step")
+	ErrInvalidFraction    = errors.New("invalid fraction")
+
+	ErrInvalidAngleFormat   = errors.New("invalid angle format")
+	ErrInvalidUTMCoordinate = errors.New("invalid utm coordinate")
+	ErrInvalidCoordinate    = errors.New("invalid coordinate")
+	ErrInvalidBearing       = errors.New("invalid bearing")
+
+	ErrUnknownCity = errors.New("unknown city")
+
+	ErrUnknownPrayTimesParam = errors.New("unknown praytimes parameter")
+	ErrInvalidPrayTimesParam = errors.New("invalid praytimes parameter")
+
+	ErrInvalidProtoMessage = errors.New("invalid protocol buffers message")
 )
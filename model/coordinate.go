@@ -0,0 +1,8 @@
+package model
+
+import "github.com/naufalfmm/angle"
+
+type Coordinate struct {
+	Latitude  angle.Angle `json:"latitude"`
+	Longitude angle.Angle `json:"longitude"`
+}
@@ -0,0 +1,16 @@
+package model
+
+type (
+	// FieldDescriptor describes a configurable field of the option for generating the settings form.
+	// The minimum and maximum are left nil for the unbounded field and the values list the codes of the enum field.
+	FieldDescriptor struct {
+		Name    string   `json:"name"`
+		Type    string   `json:"type"`
+		Unit    string   `json:"unit,omitempty"`
+		Minimum *float64 `json:"minimum,omitempty"`
+		Maximum *float64 `json:"maximum,omitempty"`
+		Values  []string `json:"values,omitempty"`
+	}
+
+	FieldDescriptors []FieldDescriptor
+)
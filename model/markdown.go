@@ -0,0 +1,58 @@
+package model
+
+import (
+	"io"
+	"strings"
+
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+)
+
+const markdownDateFormat = "2006-01-02"
+
+// ToMarkdown writes the GitHub flavored markdown table of the schedule with the date column followed by the salat columns of the first day.
+// The salat missing on a day is left blank.
+func (p PeriodicAllSalatTime) ToMarkdown(w io.Writer) error {
+	var salats []salatEnum.Salat
+	if len(p) > 0 {
+		for _, salatTime := range p[0].SalatTimes {
+			salats = append(salats, salatTime.Salat)
+		}
+	}
+
+	header := []string{"Date"}
+	alignment := []string{":---"}
+	for _, salat := range salats {
+		header = append(header, salat.Name())
+		alignment = append(alignment, ":---:")
+	}
+
+	var sb strings.Builder
+	writeMarkdownRow(&sb, header)
+	writeMarkdownRow(&sb, alignment)
+
+	for _, allSalatTime := range p {
+		times := make(map[salatEnum.Salat]string, len(allSalatTime.SalatTimes))
+		for _, salatTime := range allSalatTime.SalatTimes {
+			times[salatTime.Salat] = prayTimesInvalidTime
+			if !salatTime.Unavailable {
+				times[salatTime.Salat] = salatTime.Time.Format(prayTimesTimeFormat)
+			}
+		}
+
+		row := []string{allSalatTime.Date.Format(markdownDateFormat)}
+		for _, salat := range salats {
+			row = append(row, times[salat])
+		}
+
+		writeMarkdownRow(&sb, row)
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func writeMarkdownRow(sb *strings.Builder, cells []string) {
+	sb.WriteString("| ")
+	sb.WriteString(strings.Join(cells, " | "))
+	sb.WriteString(" |\n")
+}
@@ -0,0 +1,113 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/naufalfmm/moslem-salat-times/consts"
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+)
+
+const (
+	prayTimesTimeFormat        = "15:04"
+	prayTimesSecondsTimeFormat = "15:04:05"
+
+	// prayTimesInvalidTime is rendered for the unavailable salat as PrayTimes.org does
+	prayTimesInvalidTime = "-----"
+
+	lineSeparator = " | "
+)
+
+type (
+	// PrayTimes is the PrayTimes.org compatible representation of the salat times of a day
+	PrayTimes struct {
+		Imsak    string `json:"imsak"`
+		Fajr     string `json:"fajr"`
+		Sunrise  string `json:"sunrise"`
+		Dhuhr    string `json:"dhuhr"`
+		Asr      string `json:"asr"`
+		Sunset   string `json:"sunset"`
+		Maghrib  string `json:"maghrib"`
+		Isha     string `json:"isha"`
+		Midnight string `json:"midnight"`
+	}
+
+	PeriodicPrayTimes []PrayTimes
+)
+
+func (a AllSalatTime) ToPrayTimes() PrayTimes {
+	return a.toPrayTimes(prayTimesTimeFormat)
+}
+
+// ToPrayTimesWithSeconds renders the times with the seconds. The seconds are kept only by the NoRounding option.
+func (a AllSalatTime) ToPrayTimesWithSeconds() PrayTimes {
+	return a.toPrayTimes(prayTimesSecondsTimeFormat)
+}
+
+func (a AllSalatTime) toPrayTimes(layout string) PrayTimes {
+	prayTimes := PrayTimes{}
+
+	for _, salatTime := range a.SalatTimes {
+		formatted := salatTime.Time.Format(layout)
+		if salatTime.Unavailable {
+			formatted = prayTimesInvalidTime
+		}
+
+		switch salatTime.Salat {
+		case salatEnum.Fajr:
+			prayTimes.Fajr = formatted
+			prayTimes.Imsak = formatted
+			if !salatTime.Unavailable {
+				prayTimes.Imsak = salatTime.Time.Add(-time.Duration(consts.ImsakBeforeFajrMinute * float64(time.Minute))).Format(layout)
+			}
+		case salatEnum.Sunrise:
+			prayTimes.Sunrise = formatted
+		case salatEnum.Dhuhr:
+			prayTimes.Dhuhr = formatted
+		case salatEnum.Asr:
+			prayTimes.Asr = formatted
+		case salatEnum.Sunset:
+			prayTimes.Sunset = formatted
+		case salatEnum.Maghrib:
+			prayTimes.Maghrib = formatted
+		case salatEnum.Isha:
+			prayTimes.Isha = formatted
+		case salatEnum.Midnight:
+			prayTimes.Midnight = formatted
+		}
+	}
+
+	return prayTimes
+}
+
+// ToLine renders the salat times on one line in their order, e.g. "Fajr 04:42 | Sunrise 06:03 | Dhuhr 11:58"
+func (a AllSalatTime) ToLine() string {
+	parts := make([]string, len(a.SalatTimes))
+	for i, salatTime := range a.SalatTimes {
+		formatted := prayTimesInvalidTime
+		if !salatTime.Unavailable {
+			formatted = salatTime.Time.Format(prayTimesTimeFormat)
+		}
+
+		parts[i] = salatTime.Salat.Name() + " " + formatted
+	}
+
+	return strings.Join(parts, lineSeparator)
+}
+
+func (p PeriodicAllSalatTime) ToPrayTimes() PeriodicPrayTimes {
+	return p.toPrayTimes(prayTimesTimeFormat)
+}
+
+func (p PeriodicAllSalatTime) ToPrayTimesWithSeconds() PeriodicPrayTimes {
+	return p.toPrayTimes(prayTimesSecondsTimeFormat)
+}
+
+func (p PeriodicAllSalatTime) toPrayTimes(layout string) PeriodicPrayTimes {
+	periodicPrayTimes := make(PeriodicPrayTimes, len(p))
+	for i, allSalatTime := range p {
+		periodicPrayTimes[i] = allSalatTime.toPrayTimes(layout)
+	}
+
+	return periodicPrayTimes
+}
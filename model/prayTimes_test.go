@@ -0,0 +1,92 @@
+package model
+
+import (
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+)
+
+func TestAllSalatTimeToPrayTimesJSON(t *testing.T) {
+	jakarta := time.FixedZone("0700", 7*60*60)
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, jakarta)
+
+	at := func(hour, minute int) time.Time {
+		return date.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+	}
+
+	allSalatTime := AllSalatTime{
+		Date: date,
+		SalatTimes: PeriodicSalatTime{
+			{Salat: salatEnum.Midnight, Time: at(0, 2)},
+			{Salat: salatEnum.Fajr, Time: at(4, 35)},
+			{Salat: salatEnum.Sunrise, Time: at(5, 52)},
+			{Salat: salatEnum.Dhuhr, Time: at(12, 3)},
+			{Salat: salatEnum.Asr, Time: at(15, 14)},
+			{Salat: salatEnum.Sunset, Time: at(18, 12)},
+			{Salat: salatEnum.Maghrib, Time: at(18, 14)},
+			{Salat: salatEnum.Isha, Time: at(19, 26)},
+		},
+	}
+
+	encoded, err := json.Marshal(allSalatTime.ToPrayTimes())
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	prayTimes := map[string]string{}
+	if err := json.Unmarshal(encoded, &prayTimes); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	keys := make([]string, 0, len(prayTimes))
+	for key := range prayTimes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	if got, want := strings.Join(keys, ","), "asr,dhuhr,fajr,imsak,isha,maghrib,midnight,sunrise,sunset"; got != want {
+		t.Errorf("keys = %s, want %s", got, want)
+	}
+
+	hourMinute := regexp.MustCompile(`^\d{2}:\d{2}$`)
+	for key, value := range prayTimes {
+		if !hourMinute.MatchString(value) {
+			t.Errorf("%s = %q, want HH:mm", key, value)
+		}
+	}
+
+	want := map[string]string{
+		"imsak": "04:25", "fajr": "04:35", "sunrise": "05:52", "dhuhr": "12:03", "asr": "15:14",
+		"sunset": "18:12", "maghrib": "18:14", "isha": "19:26", "midnight": "00:02",
+	}
+	for key, value := range want {
+		if prayTimes[key] != value {
+			t.Errorf("%s = %q, want %q", key, prayTimes[key], value)
+		}
+	}
+}
+
+func TestAllSalatTimeToPrayTimesUnavailable(t *testing.T) {
+	date := time.Date(2024, time.December, 21, 0, 0, 0, 0, time.UTC)
+
+	prayTimes := AllSalatTime{
+		Date: date,
+		SalatTimes: PeriodicSalatTime{
+			{Salat: salatEnum.Fajr, Unavailable: true},
+			{Salat: salatEnum.Dhuhr, Time: date.Add(11*time.Hour + 58*time.Minute)},
+		},
+	}.ToPrayTimesWithSeconds()
+
+	if prayTimes.Fajr != "-----" || prayTimes.Imsak != "-----" {
+		t.Errorf("unavailable fajr and imsak = %q, %q, want -----", prayTimes.Fajr, prayTimes.Imsak)
+	}
+
+	if prayTimes.Dhuhr != "11:58:00" {
+		t.Errorf("dhuhr = %q, want 11:58:00", prayTimes.Dhuhr)
+	}
+}
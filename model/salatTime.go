@@ -3,14 +3,19 @@ package model
 import (
 	"time"
 
+	roundingTimeOptionEnum "github.com/naufalfmm/moslem-salat-times/enum/roundingTimeOption"
 	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
 )
 
 type (
 	SalatTime struct {
-		Date  time.Time       `json:"date"`
-		Salat salatEnum.Salat `json:"salat"`
-		Time  time.Time       `json:"time"`
+		Date    time.Time       `json:"date"`
+		Salat   salatEnum.Salat `json:"salat"`
+		Time    time.Time       `json:"time"`
+		RawTime time.Time       `json:"raw_time"`
+
+		// Unavailable marks the salat which does not occur on the date, e.g. the sunrise of the polar night, leaving the times zero
+		Unavailable bool `json:"unavailable,omitempty"`
 	}
 
 	PeriodicSalatTime []SalatTime
@@ -18,7 +23,116 @@ type (
 	AllSalatTime struct {
 		Date       time.Time         `json:"date"`
 		SalatTimes PeriodicSalatTime `json:"salat_times"`
+
+		// Label names the special handling of the date, e.g. eid
+		Label string `json:"label,omitempty"`
 	}
 
 	PeriodicAllSalatTime []AllSalatTime
+
+	// SpecialHandling labels the date and replaces the times of its salats by the overrides, e.g. the fixed jumu'ah or eid time
+	SpecialHandling struct {
+		Label     string                        `json:"label"`
+		Overrides map[salatEnum.Salat]time.Time `json:"overrides,omitempty"`
+	}
 )
+
+// IsDSTTransition reports whether the UTC offset of the date's location changes during the date
+func (a AllSalatTime) IsDSTTransition() bool {
+	year, month, day := a.Date.Date()
+
+	_, startOffset := time.Date(year, month, day, 0, 0, 0, 0, a.Date.Location()).Zone()
+	_, endOffset := time.Date(year, month, day+1, 0, 0, 0, 0, a.Date.Location()).Zone()
+
+	return startOffset != endOffset
+}
+
+// ChangedSince returns the salat times whose time of the day moved from the previous day by at least the threshold.
+// The salat missing on the previous day is always returned. The salat unavailable on both days is skipped and the salat turning
+// available or unavailable is always returned, with the zero time when it turns unavailable.
+func (a AllSalatTime) ChangedSince(prev AllSalatTime, threshold time.Duration) map[salatEnum.Salat]time.Time {
+	prevSalatTimes := make(map[salatEnum.Salat]SalatTime, len(prev.SalatTimes))
+	for _, salatTime := range prev.SalatTimes {
+		prevSalatTimes[salatTime.Salat] = salatTime
+	}
+
+	changes := map[salatEnum.Salat]time.Time{}
+	for _, salatTime := range a.SalatTimes {
+		prevSalatTime, ok := prevSalatTimes[salatTime.Salat]
+		if !ok {
+			changes[salatTime.Salat] = salatTime.Time
+			continue
+		}
+
+		if prevSalatTime.Unavailable || salatTime.Unavailable {
+			if prevSalatTime.Unavailable != salatTime.Unavailable {
+				changes[salatTime.Salat] = salatTime.Time
+			}
+
+			continue
+		}
+
+		moved := timeOfDay(salatTime.Time, a.Date) - timeOfDay(prevSalatTime.Time, prev.Date)
+		if moved < 0 {
+			moved = -moved
+		}
+
+		if moved >= threshold {
+			changes[salatTime.Salat] = salatTime.Time
+		}
+	}
+
+	return changes
+}
+
+// WithSpecialHandling returns the copy labeled by the special handling with the overridden salat times replaced.
+// The override of the salat missing from the day is ignored.
+func (a AllSalatTime) WithSpecialHandling(special SpecialHandling) AllSalatTime {
+	salatTimes := make(PeriodicSalatTime, len(a.SalatTimes))
+	for i, salatTime := range a.SalatTimes {
+		if override, ok := special.Overrides[salatTime.Salat]; ok {
+			salatTime.Time = override
+			salatTime.RawTime = override
+			salatTime.Unavailable = false
+		}
+
+		salatTimes[i] = salatTime
+	}
+
+	a.SalatTimes = salatTimes
+	a.Label = special.Label
+
+	return a
+}
+
+// timeOfDay returns the duration of the time since the local midnight of the date, negative for the time before the date such as the midnight salat
+func timeOfDay(t, date time.Time) time.Duration {
+	year, month, day := date.Date()
+
+	return t.Sub(time.Date(year, month, day, 0, 0, 0, 0, date.Location()))
+}
+
+// Round returns the copy with every time rounded again from the raw time by the rounding option
+func (a AllSalatTime) Round(roundingTimeOpt roundingTimeOptionEnum.RoundingTimeOption) AllSalatTime {
+	salatTimes := make(PeriodicSalatTime, len(a.SalatTimes))
+	for i, salatTime := range a.SalatTimes {
+		if !salatTime.Unavailable {
+			salatTime.Time = roundingTimeOpt.RoundTime(salatTime.RawTime)
+		}
+
+		salatTimes[i] = salatTime
+	}
+
+	a.SalatTimes = salatTimes
+
+	return a
+}
+
+func (p PeriodicAllSalatTime) Round(roundingTimeOpt roundingTimeOptionEnum.RoundingTimeOption) PeriodicAllSalatTime {
+	periodicAllSalatTimes := make(PeriodicAllSalatTime, len(p))
+	for i, allSalatTime := range p {
+		periodicAllSalatTimes[i] = allSalatTime.Round(roundingTimeOpt)
+	}
+
+	return periodicAllSalatTimes
+}
@@ -0,0 +1,81 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	roundingTimeOptionEnum "github.com/naufalfmm/moslem-salat-times/enum/roundingTimeOption"
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+)
+
+func TestAllSalatTimeChangedSince(t *testing.T) {
+	prevDate := time.Date(2024, time.November, 20, 0, 0, 0, 0, time.UTC)
+	date := prevDate.AddDate(0, 0, 1)
+
+	at := func(date time.Time, hour, minute int) time.Time {
+		return date.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+	}
+
+	prev := AllSalatTime{
+		Date: prevDate,
+		SalatTimes: PeriodicSalatTime{
+			{Date: prevDate, Salat: salatEnum.Fajr, Time: at(prevDate, 5, 0)},
+			{Date: prevDate, Salat: salatEnum.Sunrise, Unavailable: true},
+			{Date: prevDate, Salat: salatEnum.Dhuhr, Time: at(prevDate, 12, 0)},
+			{Date: prevDate, Salat: salatEnum.Maghrib, Time: at(prevDate, 15, 0)},
+			{Date: prevDate, Salat: salatEnum.Isha, Unavailable: true},
+		},
+	}
+
+	curr := AllSalatTime{
+		Date: date,
+		SalatTimes: PeriodicSalatTime{
+			{Date: date, Salat: salatEnum.Fajr, Time: at(date, 5, 5)},
+			{Date: date, Salat: salatEnum.Sunrise, Unavailable: true},
+			{Date: date, Salat: salatEnum.Dhuhr, Time: at(date, 12, 1)},
+			{Date: date, Salat: salatEnum.Maghrib, Unavailable: true},
+			{Date: date, Salat: salatEnum.Isha, Time: at(date, 18, 0)},
+			{Date: date, Salat: salatEnum.Asr, Time: at(date, 13, 0)},
+		},
+	}
+
+	want := map[salatEnum.Salat]time.Time{
+		salatEnum.Fajr:    at(date, 5, 5),
+		salatEnum.Maghrib: {},
+		salatEnum.Isha:    at(date, 18, 0),
+		salatEnum.Asr:     at(date, 13, 0),
+	}
+
+	changes := curr.ChangedSince(prev, 5*time.Minute)
+	if len(changes) != len(want) {
+		t.Errorf("ChangedSince() = %v, want %v", changes, want)
+	}
+
+	for salat, wantTime := range want {
+		if gotTime, ok := changes[salat]; !ok || !gotTime.Equal(wantTime) {
+			t.Errorf("ChangedSince()[%s] = %v, %v, want %v", salat.Code(), gotTime, ok, wantTime)
+		}
+	}
+}
+
+func TestAllSalatTimeRoundKeepsLabel(t *testing.T) {
+	date := time.Date(2024, time.April, 10, 0, 0, 0, 0, time.UTC)
+	rawTime := date.Add(6*time.Hour + 30*time.Minute + 20*time.Second)
+
+	allSalatTime := AllSalatTime{
+		Date: date,
+		SalatTimes: PeriodicSalatTime{
+			{Date: date, Salat: salatEnum.Fajr, Time: rawTime, RawTime: rawTime},
+		},
+		Label: "eid",
+	}
+
+	rounded := allSalatTime.Round(roundingTimeOptionEnum.MinuteCeil)
+	if rounded.Label != "eid" {
+		t.Errorf("Round() label = %q, want %q", rounded.Label, "eid")
+	}
+
+	if want := date.Add(6*time.Hour + 31*time.Minute); !rounded.SalatTimes[0].Time.Equal(want) {
+		t.Errorf("Round() time = %v, want %v", rounded.SalatTimes[0].Time, want)
+	}
+}
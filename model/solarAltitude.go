@@ -0,0 +1,16 @@
+package model
+
+import (
+	"time"
+
+	"github.com/naufalfmm/angle"
+)
+
+type (
+	SolarAltitude struct {
+		Time     time.Time   `json:"time"`
+		Altitude angle.Angle `json:"altitude"`
+	}
+
+	SolarAltitudeSeries []SolarAltitude
+)
@@ -1,6 +1,11 @@
 package moslemSalatTimes
 
 import (
+	"time"
+
+	"github.com/naufalfmm/angle"
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	twilightEnum "github.com/naufalfmm/moslem-salat-times/enum/twilight"
 	"github.com/naufalfmm/moslem-salat-times/model"
 	"github.com/naufalfmm/moslem-salat-times/option"
 )
@@ -16,6 +21,38 @@ type MoslemSalatTimes interface {
 	Isha(opt option.Option) (model.PeriodicSalatTime, error)
 
 	AllTimes(opt option.Option) (model.PeriodicAllSalatTime, error)
+	AtSeaLevel(opt option.Option) (model.PeriodicAllSalatTime, error)
+	AsrBothMazhab(opt option.Option, date time.Time) (time.Time, time.Time, error)
+	AsrEnd(opt option.Option, date time.Time) (time.Time, error)
+	OffsetsFromDhuhr(opt option.Option, date time.Time) (map[salatEnum.Salat]time.Duration, error)
+	NextPrayers(opt option.Option, now time.Time, n int) (model.PeriodicSalatTime, error)
+	ApparentSolarClock(opt option.Option) (model.PeriodicAllSalatTime, error)
+	PrayerProgress(opt option.Option, now time.Time) (salatEnum.Salat, float64, error)
+	TodayLine(opt option.Option, now time.Time) (string, error)
+	TahajjudStart(opt option.Option, fraction float64, date time.Time) (time.Time, error)
+	TransitDetails(opt option.Option, date time.Time) (time.Duration, time.Duration, time.Duration, error)
+
+	Qibla(opt option.Option) (angle.Angle, error)
+	QiblaError(opt option.Option, actualBearing angle.Angle) (angle.Angle, error)
+	AllTimesWithQibla(opt option.Option, date time.Time) (model.AllSalatTime, angle.Angle, error)
+	QiblaAlongRoute(opt option.Option, route []model.Coordinate) []angle.Angle
+	TimesEnRoute(opt option.Option, startPos, endPos model.Coordinate, startTime, endTime time.Time, samples int) (model.PeriodicAllSalatTime, error)
+
+	DaylightDelta(opt option.Option, date time.Time) (time.Duration, error)
+	TwilightDuration(opt option.Option, kind twilightEnum.Twilight, date time.Time) (time.Duration, time.Duration, error)
+	FajrValidRange(opt option.Option, year int) (time.Time, time.Time, bool, error)
+	SelfTest(opt option.Option, year int) error
+	MonthlyAverages(opt option.Option, year int) (map[time.Month]map[salatEnum.Salat]time.Duration, error)
+	IshaAfterMaghrib(opt option.Option, year int) (map[time.Time]time.Duration, error)
+	YearGrid(opt option.Option, year int, salat salatEnum.Salat) ([][]bool, error)
+	Solstices(opt option.Option, year int) (time.Time, time.Time, time.Time, time.Time, error)
+	AngleAdequacy(opt option.Option, year int, latitude angle.Angle, angles []angle.Angle) ([]int, error)
+	IsMakruhTime(opt option.Option, t time.Time) (bool, string, error)
+	ZawalWindow(opt option.Option, date time.Time) (time.Time, time.Time, error)
+	SunAltitudeAt(opt option.Option, salat salatEnum.Salat, date time.Time) (angle.Angle, error)
+	SunHourAngle(opt option.Option, t time.Time) (angle.Angle, error)
+	SolarAltitudeSeries(opt option.Option, date time.Time, step time.Duration) (model.SolarAltitudeSeries, error)
+	TimeAtAzimuth(opt option.Option, date time.Time, azimuth angle.Angle) ([]time.Time, error)
 
 	GetOption() option.Option
 }
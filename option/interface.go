@@ -4,31 +4,69 @@ import (
 	"time"
 
 	"github.com/naufalfmm/angle"
+	accuracyModeEnum "github.com/naufalfmm/moslem-salat-times/enum/accuracyMode"
+	dhuhrDefinitionEnum "github.com/naufalfmm/moslem-salat-times/enum/dhuhrDefinition"
 	higherLatEnum "github.com/naufalfmm/moslem-salat-times/enum/higherLat"
+	longitudeConventionEnum "github.com/naufalfmm/moslem-salat-times/enum/longitudeConvention"
 	mazhabEnum "github.com/naufalfmm/moslem-salat-times/enum/mazhab"
 	periodicalEnum "github.com/naufalfmm/moslem-salat-times/enum/periodical"
 	roundingTimeOptionEnum "github.com/naufalfmm/moslem-salat-times/enum/roundingTimeOption"
 	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	shafaqEnum "github.com/naufalfmm/moslem-salat-times/enum/shafaq"
+	sunDiscEnum "github.com/naufalfmm/moslem-salat-times/enum/sunDisc"
 	sunZenithEnum "github.com/naufalfmm/moslem-salat-times/enum/sunZenith"
+	twilightEnum "github.com/naufalfmm/moslem-salat-times/enum/twilight"
+	"github.com/naufalfmm/moslem-salat-times/model"
 	"github.com/naufalfmm/moslem-salat-times/utils/sunPositions"
 )
 
 type Option interface {
 	SetDateRange(dateStart, dateEnd time.Time) Option
+	SetDateRangeExclusive(dateStart, dateEnd time.Time) Option
+	SetExcludedDates(dates []time.Time) Option
+	SetSpecialDates(specialDates map[time.Time]model.SpecialHandling) Option
 	SetNow() Option
+	SetClock(clock func() time.Time) Option
 	SetDatePeriodical(dateStart time.Time, periodical periodicalEnum.Periodical) Option
 	SetPeriodical(periodical periodicalEnum.Periodical) Option
+	SetWeekStart(weekStart time.Weekday) Option
+	SetLongitudeConvention(convention longitudeConventionEnum.LongitudeConvention) Option
 	SetLatitudeLongitude(latitude, longitude angle.Angle) Option
+	SetCity(name string) (Option, error)
+	SetQiblaReference(latitude, longitude angle.Angle) Option
 	SetElevation(elevation float64) Option
 	SetMazhab(mazhab mazhabEnum.Mazhab) Option
 	SetHigherLatitudeMethod(higherLatMethod higherLatEnum.HigherLat) Option
+	SetNearestLatitude(referenceLatitude angle.Angle) Option
 	SetRoundingTimeOption(roundingTimeOpt roundingTimeOptionEnum.RoundingTimeOption) Option
+	SetGlobalOffset(offset time.Duration) Option
+	SetAccuracyMode(accuracyMode accuracyModeEnum.AccuracyMode) Option
+	SetSolarOverride(declination angle.Angle, equationOfTime time.Duration) Option
+	ClearSolarOverride() Option
+	SetEphemeris(ephemeris func(date time.Time) (angle.Angle, time.Duration, error)) Option
+	ClearEphemeris() Option
 
 	SetTimezoneOffset(timezoneOffset float64) Option
+	SetTimezoneOffsetSeconds(timezoneOffsetSeconds int) Option
 	SetTimezone(timezone *time.Location) Option
 
 	SetFajrIshaZenith(fajrZenith, ishaZenith angle.Angle) Option
+	SetSymmetricTwilight(zenith angle.Angle) Option
+	SetTwilightConvention(fajr, isha twilightEnum.Twilight) Option
 	SetSunZenith(sunZenith sunZenithEnum.SunZenith) Option
+	SetMaghribZenith(maghribZenith angle.Angle) Option
+	SetSunriseSunsetZenith(sunriseSunsetZenith angle.Angle) Option
+	SetSunDiscReference(sunDiscReference sunDiscEnum.SunDisc) Option
+	SetDhuhrDefinition(dhuhrDefinition dhuhrDefinitionEnum.DhuhrDefinition) Option
+	SetIshaIgnoresElevation(ignore bool) Option
+	SetUseGeocentricLatitude(useGeocentricLatitude bool) Option
+	SetShafaqType(shafaqType shafaqEnum.Shafaq) Option
+
+	SetSalats(salats ...salatEnum.Salat) Option
+	SetMakruhWidths(afterSunrise, beforeSunset time.Duration) Option
+	SetZawalWidth(width time.Duration) Option
+	SetMinNightFraction(fraction float64) Option
+	SetTahajjudUntilFajr(untilFajr bool) Option
 
 	ValidateBySalat(salat salatEnum.Salat) error
 
@@ -37,9 +75,34 @@ type Option interface {
 	CalculateSunriseSunsetHighAltitude(declination angle.Angle) angle.Angle
 	CalculateAsrAngle(declination angle.Angle) angle.Angle
 	CalculateIshaHighAltitude(declination angle.Angle) (angle.Angle, sunZenithEnum.IshaZenithType)
+	CalculateMaghribHighAltitude(declination angle.Angle) angle.Angle
+	CalculateDepressionHighAltitude(depression, declination angle.Angle) angle.Angle
+	CalculateShafaqIsha(date time.Time) (time.Duration, bool)
+	CalculateDhuhrMargin(declination angle.Angle) angle.Angle
+	CalculateSunAltitude(declination, hourAngle angle.Angle) angle.Angle
+	CalculateSunAzimuth(declination, hourAngle angle.Angle) angle.Angle
+	CalculateQibla() angle.Angle
+	CalculateQiblaFrom(latitude, longitude angle.Angle) angle.Angle
 
 	RoundTime(t time.Time) time.Time
+	GetGlobalOffset() time.Duration
 
 	GetSunPositions() sunPositions.SunPositions
 	GetDateRange() (time.Time, time.Time)
+	Now() time.Time
+	GetTimezone() *time.Location
+	GetSalats() []salatEnum.Salat
+	GetQiblaReference() model.Coordinate
+	GetMakruhWidths() (time.Duration, time.Duration)
+	GetZawalWidth() time.Duration
+	GetLongitude() angle.Angle
+	GetNearestLatitude() angle.Angle
+	GetMinNightFraction() float64
+	GetTahajjudUntilFajr() bool
+	IsExcludedDate(date time.Time) bool
+	GetSpecialHandling(date time.Time) (model.SpecialHandling, bool)
+
+	Describe() model.FieldDescriptors
+
+	Clone() Option
 }
@@ -0,0 +1,195 @@
+package salatTimesProto
+
+import (
+	"math"
+
+	"github.com/naufalfmm/angle"
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	"github.com/naufalfmm/moslem-salat-times/model"
+)
+
+// The field numbers follow salatTimes.proto
+const (
+	salatTimeDate        = 1
+	salatTimeSalat       = 2
+	salatTimeTime        = 3
+	salatTimeRawTime     = 4
+	salatTimeUnavailable = 5
+
+	allSalatTimeDate       = 1
+	allSalatTimeSalatTimes = 2
+	allSalatTimeLabel      = 3
+
+	periodicAllSalatTimeAllSalatTimes = 1
+
+	coordinateLatitude  = 1
+	coordinateLongitude = 2
+)
+
+func marshalSalatTime(salatTime model.SalatTime) []byte {
+	e := encoder{}
+	e.timestamp(salatTimeDate, salatTime.Date)
+	e.varint(salatTimeSalat, int64(salatTime.Salat))
+	e.timestamp(salatTimeTime, salatTime.Time)
+	e.timestamp(salatTimeRawTime, salatTime.RawTime)
+	e.boolean(salatTimeUnavailable, salatTime.Unavailable)
+
+	return e.buf
+}
+
+func unmarshalSalatTime(buf []byte) (model.SalatTime, error) {
+	salatTime := model.SalatTime{}
+
+	d := decoder{buf: buf}
+	for !d.done() {
+		field, _, val, payload, decodeErr := d.next()
+		if decodeErr != nil {
+			return model.SalatTime{}, decodeErr
+		}
+
+		switch field {
+		case salatTimeDate:
+			salatTime.Date, decodeErr = decodeTimestamp(payload)
+		case salatTimeSalat:
+			salatTime.Salat = salatEnum.Salat(int32(val))
+		case salatTimeTime:
+			salatTime.Time, decodeErr = decodeTimestamp(payload)
+		case salatTimeRawTime:
+			salatTime.RawTime, decodeErr = decodeTimestamp(payload)
+		case salatTimeUnavailable:
+			salatTime.Unavailable = val != 0
+		}
+
+		if decodeErr != nil {
+			return model.SalatTime{}, decodeErr
+		}
+	}
+
+	return salatTime, nil
+}
+
+// MarshalAllSalatTime encodes the salat times of a day as the AllSalatTime message.
+// The times are encoded as google.protobuf.Timestamp, so their location is not kept.
+func MarshalAllSalatTime(allSalatTime model.AllSalatTime) []byte {
+	e := encoder{}
+	e.timestamp(allSalatTimeDate, allSalatTime.Date)
+	for _, salatTime := range allSalatTime.SalatTimes {
+		e.bytes(allSalatTimeSalatTimes, marshalSalatTime(salatTime))
+	}
+	e.str(allSalatTimeLabel, allSalatTime.Label)
+
+	return e.buf
+}
+
+// UnmarshalAllSalatTime decodes the AllSalatTime message. The times are in UTC.
+func UnmarshalAllSalatTime(buf []byte) (model.AllSalatTime, error) {
+	allSalatTime := model.AllSalatTime{
+		SalatTimes: model.PeriodicSalatTime{},
+	}
+
+	d := decoder{buf: buf}
+	for !d.done() {
+		field, _, _, payload, decodeErr := d.next()
+		if decodeErr != nil {
+			return model.AllSalatTime{}, decodeErr
+		}
+
+		switch field {
+		case allSalatTimeDate:
+			allSalatTime.Date, decodeErr = decodeTimestamp(payload)
+		case allSalatTimeSalatTimes:
+			var salatTime model.SalatTime
+			salatTime, decodeErr = unmarshalSalatTime(payload)
+			allSalatTime.SalatTimes = append(allSalatTime.SalatTimes, salatTime)
+		case allSalatTimeLabel:
+			allSalatTime.Label = string(payload)
+		}
+
+		if decodeErr != nil {
+			return model.AllSalatTime{}, decodeErr
+		}
+	}
+
+	return allSalatTime, nil
+}
+
+// Marshal encodes the salat times of the date range as the PeriodicAllSalatTime message
+func Marshal(periodicAllSalatTime model.PeriodicAllSalatTime) []byte {
+	e := encoder{}
+	for _, allSalatTime := range periodicAllSalatTime {
+		e.bytes(periodicAllSalatTimeAllSalatTimes, MarshalAllSalatTime(allSalatTime))
+	}
+
+	return e.buf
+}
+
+// Unmarshal decodes the PeriodicAllSalatTime message. The times are in UTC.
+func Unmarshal(buf []byte) (model.PeriodicAllSalatTime, error) {
+	periodicAllSalatTime := model.PeriodicAllSalatTime{}
+
+	d := decoder{buf: buf}
+	for !d.done() {
+		field, _, _, payload, decodeErr := d.next()
+		if decodeErr != nil {
+			return model.PeriodicAllSalatTime{}, decodeErr
+		}
+
+		if field != periodicAllSalatTimeAllSalatTimes {
+			continue
+		}
+
+		allSalatTime, decodeErr := UnmarshalAllSalatTime(payload)
+		if decodeErr != nil {
+			return model.PeriodicAllSalatTime{}, decodeErr
+		}
+
+		periodicAllSalatTime = append(periodicAllSalatTime, allSalatTime)
+	}
+
+	return periodicAllSalatTime, nil
+}
+
+func degreeOf(ang angle.Angle) float64 {
+	if ang.IsZero() {
+		return 0
+	}
+
+	return ang.ToDegree().ToFloat()
+}
+
+// MarshalCoordinate encodes the coordinate as the Coordinate message of the signed decimal degrees
+func MarshalCoordinate(coordinate model.Coordinate) []byte {
+	e := encoder{}
+	e.double(coordinateLatitude, degreeOf(coordinate.Latitude))
+	e.double(coordinateLongitude, degreeOf(coordinate.Longitude))
+
+	return e.buf
+}
+
+func UnmarshalCoordinate(buf []byte) (model.Coordinate, error) {
+	var lat, long float64
+
+	d := decoder{buf: buf}
+	for !d.done() {
+		field, wireType, val, _, decodeErr := d.next()
+		if decodeErr != nil {
+			return model.Coordinate{}, decodeErr
+		}
+
+		if wireType != wireFixed64 {
+			continue
+		}
+
+		switch field {
+		case coordinateLatitude:
+			lat = math.Float64frombits(val)
+		case coordinateLongitude:
+			long = math.Float64frombits(val)
+		}
+	}
+
+	return model.Coordinate{
+		Latitude:  angle.NewDegreeFromFloat(lat),
+		Longitude: angle.NewDegreeFromFloat(long),
+	}, nil
+}
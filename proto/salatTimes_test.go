@@ -0,0 +1,70 @@
+package salatTimesProto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/naufalfmm/angle"
+	mazhabEnum "github.com/naufalfmm/moslem-salat-times/enum/mazhab"
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	twilightEnum "github.com/naufalfmm/moslem-salat-times/enum/twilight"
+	"github.com/naufalfmm/moslem-salat-times/model"
+	"github.com/naufalfmm/moslem-salat-times/schedule"
+)
+
+func TestMarshalUnavailable(t *testing.T) {
+	date := time.Date(2024, time.December, 21, 0, 0, 0, 0, time.UTC)
+	opt := (&schedule.Option{}).
+		SetLatitudeLongitude(angle.NewDegreeFromFloat(78.), angle.NewDegreeFromFloat(15.6)).
+		SetTimezone(time.UTC).
+		SetTwilightConvention(twilightEnum.Astronomical, twilightEnum.Astronomical).
+		SetMazhab(mazhabEnum.Standard).
+		SetSalats(salatEnum.Sunrise, salatEnum.Dhuhr, salatEnum.Sunset).
+		SetDateRange(date, date)
+
+	periodicAllSalatTimes, err := (&schedule.Schedule{}).AllTimes(opt)
+	if err != nil {
+		t.Fatalf("AllTimes() error = %v", err)
+	}
+
+	decoded, err := Unmarshal(Marshal(periodicAllSalatTimes))
+	if err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if len(decoded) != 1 || len(decoded[0].SalatTimes) != 3 {
+		t.Fatalf("Unmarshal() = %v, want one day of three salat times", decoded)
+	}
+
+	for i, salatTime := range decoded[0].SalatTimes {
+		want := periodicAllSalatTimes[0].SalatTimes[i]
+		if salatTime.Salat != want.Salat || salatTime.Unavailable != want.Unavailable || !salatTime.Time.Equal(want.Time) {
+			t.Errorf("Unmarshal() salat time = %+v, want %+v", salatTime, want)
+		}
+
+		wantUnavailable := salatTime.Salat != salatEnum.Dhuhr
+		if salatTime.Unavailable != wantUnavailable {
+			t.Errorf("%s unavailable = %v, want %v", salatTime.Salat.Code(), salatTime.Unavailable, wantUnavailable)
+		}
+	}
+}
+
+func TestMarshalAllSalatTimeLabel(t *testing.T) {
+	date := time.Date(2024, time.April, 10, 0, 0, 0, 0, time.UTC)
+	allSalatTime := model.AllSalatTime{
+		Date: date,
+		SalatTimes: model.PeriodicSalatTime{
+			{Date: date, Salat: salatEnum.Dhuhr, Time: date.Add(12 * time.Hour), RawTime: date.Add(12 * time.Hour)},
+		},
+		Label: "eid",
+	}
+
+	decoded, err := UnmarshalAllSalatTime(MarshalAllSalatTime(allSalatTime))
+	if err != nil {
+		t.Fatalf("UnmarshalAllSalatTime() error = %v", err)
+	}
+
+	if decoded.Label != allSalatTime.Label || len(decoded.SalatTimes) != 1 || !decoded.SalatTimes[0].Time.Equal(allSalatTime.SalatTimes[0].Time) {
+		t.Errorf("UnmarshalAllSalatTime() = %+v, want %+v", decoded, allSalatTime)
+	}
+}
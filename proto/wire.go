@@ -0,0 +1,163 @@
+package salatTimesProto
+
+import (
+	"encoding/binary"
+	"math"
+	"time"
+
+	"github.com/naufalfmm/moslem-salat-times/err"
+)
+
+const (
+	wireVarint      = 0
+	wireFixed64     = 1
+	wireBytes       = 2
+	wireFixed32     = 5
+	timestampSecond = 1
+	timestampNanos  = 2
+)
+
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) tag(field int, wireType int) {
+	e.buf = binary.AppendUvarint(e.buf, uint64(field)<<3|uint64(wireType))
+}
+
+func (e *encoder) varint(field int, val int64) {
+	if val == 0 {
+		return
+	}
+
+	e.tag(field, wireVarint)
+	e.buf = binary.AppendUvarint(e.buf, uint64(val))
+}
+
+func (e *encoder) boolean(field int, val bool) {
+	if !val {
+		return
+	}
+
+	e.varint(field, 1)
+}
+
+func (e *encoder) double(field int, val float64) {
+	if val == 0 {
+		return
+	}
+
+	e.tag(field, wireFixed64)
+	e.buf = binary.LittleEndian.AppendUint64(e.buf, math.Float64bits(val))
+}
+
+func (e *encoder) bytes(field int, val []byte) {
+	e.tag(field, wireBytes)
+	e.buf = binary.AppendUvarint(e.buf, uint64(len(val)))
+	e.buf = append(e.buf, val...)
+}
+
+// str encodes the string and leaves out the empty one
+func (e *encoder) str(field int, val string) {
+	if val == "" {
+		return
+	}
+
+	e.bytes(field, []byte(val))
+}
+
+// timestamp encodes the google.protobuf.Timestamp and leaves out the zero time
+func (e *encoder) timestamp(field int, t time.Time) {
+	if t.IsZero() {
+		return
+	}
+
+	ts := encoder{}
+	ts.varint(timestampSecond, t.Unix())
+	ts.varint(timestampNanos, int64(t.Nanosecond()))
+
+	e.bytes(field, ts.buf)
+}
+
+type decoder struct {
+	buf []byte
+}
+
+// next reads the next field. The value is the varint or the fixed bits for the scalar and the payload for the bytes.
+func (d *decoder) next() (int, int, uint64, []byte, error) {
+	key, n := binary.Uvarint(d.buf)
+	if n <= 0 {
+		return 0, 0, 0, nil, err.ErrInvalidProtoMessage
+	}
+	d.buf = d.buf[n:]
+
+	field, wireType := int(key>>3), int(key&7)
+
+	switch wireType {
+	case wireVarint:
+		val, n := binary.Uvarint(d.buf)
+		if n <= 0 {
+			return 0, 0, 0, nil, err.ErrInvalidProtoMessage
+		}
+		d.buf = d.buf[n:]
+
+		return field, wireType, val, nil, nil
+	case wireFixed64:
+		if len(d.buf) < 8 {
+			return 0, 0, 0, nil, err.ErrInvalidProtoMessage
+		}
+		val := binary.LittleEndian.Uint64(d.buf)
+		d.buf = d.buf[8:]
+
+		return field, wireType, val, nil, nil
+	case wireFixed32:
+		if len(d.buf) < 4 {
+			return 0, 0, 0, nil, err.ErrInvalidProtoMessage
+		}
+		val := binary.LittleEndian.Uint32(d.buf)
+		d.buf = d.buf[4:]
+
+		return field, wireType, uint64(val), nil, nil
+	case wireBytes:
+		length, n := binary.Uvarint(d.buf)
+		if n <= 0 || uint64(len(d.buf)-n) < length {
+			return 0, 0, 0, nil, err.ErrInvalidProtoMessage
+		}
+		val := d.buf[n : n+int(length)]
+		d.buf = d.buf[n+int(length):]
+
+		return field, wireType, 0, val, nil
+	}
+
+	return 0, 0, 0, nil, err.ErrInvalidProtoMessage
+}
+
+func (d *decoder) done() bool {
+	return len(d.buf) == 0
+}
+
+// decodeTimestamp decodes the google.protobuf.Timestamp into the UTC time
+func decodeTimestamp(buf []byte) (time.Time, error) {
+	var seconds, nanos int64
+
+	d := decoder{buf: buf}
+	for !d.done() {
+		field, wireType, val, _, decodeErr := d.next()
+		if decodeErr != nil {
+			return time.Time{}, decodeErr
+		}
+
+		if wireType != wireVarint {
+			continue
+		}
+
+		switch field {
+		case timestampSecond:
+			seconds = int64(val)
+		case timestampNanos:
+			nanos = int64(int32(val))
+		}
+	}
+
+	return time.Unix(seconds, nanos).UTC(), nil
+}
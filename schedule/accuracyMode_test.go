@@ -0,0 +1,44 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	accuracyModeEnum "github.com/naufalfmm/moslem-salat-times/enum/accuracyMode"
+)
+
+func TestAccuracyModeFastBound(t *testing.T) {
+	dateStart := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	dateEnd := time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	opt := newTestOption(45., 7.68, time.UTC, dateStart).SetDateRange(dateStart, dateEnd)
+
+	precises, err := (&Schedule{}).AllTimes(opt.Clone().SetAccuracyMode(accuracyModeEnum.Precise))
+	if err != nil {
+		t.Fatalf("AllTimes() precise error = %v", err)
+	}
+
+	fasts, err := (&Schedule{}).AllTimes(opt.Clone().SetAccuracyMode(accuracyModeEnum.Fast))
+	if err != nil {
+		t.Fatalf("AllTimes() fast error = %v", err)
+	}
+
+	if len(precises) != 366 || len(fasts) != len(precises) {
+		t.Fatalf("AllTimes() lengths = %d, %d, want 366", len(precises), len(fasts))
+	}
+
+	for i := range precises {
+		for j, precise := range precises[i].SalatTimes {
+			fast := fasts[i].SalatTimes[j]
+
+			diff := fast.RawTime.Sub(precise.RawTime)
+			if diff < 0 {
+				diff = -diff
+			}
+
+			if diff > time.Minute {
+				t.Errorf("%s %s: fast %v differs from precise %v by %v, want within 1 minute", precise.Date.Format("2006-01-02"), precise.Salat.Code(), fast.RawTime, precise.RawTime, diff)
+			}
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package schedule
+
+import (
+	"github.com/naufalfmm/angle"
+	"github.com/naufalfmm/moslem-salat-times/option"
+	"github.com/naufalfmm/moslem-salat-times/utils/salatHighAltitude"
+)
+
+// AngleAdequacy counts the days of the year on which each zenith angle is reached at the latitude.
+// The angles are applied at the geometric horizon and the counts are aligned with the given angles, so 18° and 18°0'0" are counted alike.
+func (s *Schedule) AngleAdequacy(opt option.Option, year int, latitude angle.Angle, angles []angle.Angle) ([]int, error) {
+	if err := opt.ValidateBySalat(0); err != nil {
+		return nil, err
+	}
+
+	yearOpt, err := yearOption(opt, year)
+	if err != nil {
+		return nil, err
+	}
+
+	adequacy := make([]int, len(angles))
+	for i, ang := range angles {
+		for _, sunPosition := range yearOpt.GetSunPositions() {
+			if !isAngleUndefined(salatHighAltitude.CalcSalatHighAltitude(ang.ToDecimal(), latitude, sunPosition.Declination, 0)) {
+				adequacy[i]++
+			}
+		}
+	}
+
+	return adequacy, nil
+}
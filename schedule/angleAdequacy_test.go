@@ -0,0 +1,50 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/naufalfmm/angle"
+)
+
+func TestAngleAdequacy(t *testing.T) {
+	angles := []angle.Angle{
+		angle.NewDegreeFromFloat(15.),
+		angle.NewDegreeFromFloat(18.),
+		angle.NewFromDegreeMinuteSecond(18., 0., 0.),
+		angle.NewDegreeFromFloat(19.5),
+	}
+	opt := newTestOption(0., 0., time.UTC, time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC))
+
+	mid, err := (&Schedule{}).AngleAdequacy(opt, 2024, angle.NewDegreeFromFloat(40.), angles)
+	if err != nil {
+		t.Fatalf("AngleAdequacy() at 40°N error = %v", err)
+	}
+
+	high, err := (&Schedule{}).AngleAdequacy(opt, 2024, angle.NewDegreeFromFloat(60.), angles)
+	if err != nil {
+		t.Fatalf("AngleAdequacy() at 60°N error = %v", err)
+	}
+
+	if len(mid) != len(angles) || len(high) != len(angles) {
+		t.Fatalf("AngleAdequacy() lengths = %d, %d, want %d", len(mid), len(high), len(angles))
+	}
+
+	for i, ang := range angles {
+		if mid[i] != 366 {
+			t.Errorf("AngleAdequacy() at 40°N for %s = %d, want 366", ang, mid[i])
+		}
+
+		if high[i] >= mid[i] {
+			t.Errorf("AngleAdequacy() at 60°N for %s = %d, want fewer than %d", ang, high[i], mid[i])
+		}
+	}
+
+	if high[1] != high[2] {
+		t.Errorf("AngleAdequacy() at 60°N for 18° = %d and 18°0'0\" = %d, want equal", high[1], high[2])
+	}
+
+	if high[3] >= high[0] {
+		t.Errorf("AngleAdequacy() at 60°N for 19.5° = %d, want fewer than 15° = %d", high[3], high[0])
+	}
+}
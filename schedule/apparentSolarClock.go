@@ -0,0 +1,39 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/naufalfmm/moslem-salat-times/model"
+	"github.com/naufalfmm/moslem-salat-times/option"
+)
+
+// ApparentSolarClock returns the salat times on the apparent solar clock of the location, where the sun transits exactly at 12:00.
+// The unavailable salat times are left zero.
+func (s *Schedule) ApparentSolarClock(opt option.Option) (model.PeriodicAllSalatTime, error) {
+	periodicAllSalatTimes, err := s.allTimes(opt)
+	if err != nil {
+		return model.PeriodicAllSalatTime{}, err
+	}
+
+	opt, err = opt.CalculateSunPositions()
+	if err != nil {
+		return model.PeriodicAllSalatTime{}, err
+	}
+
+	for i, sunPosition := range opt.GetSunPositions() {
+		shift := time.Duration((12. - sunPosition.SunTransitTime.ToDegree().ToFloat()) * float64(time.Hour))
+
+		for j, salatTime := range periodicAllSalatTimes[i].SalatTimes {
+			if salatTime.Unavailable {
+				continue
+			}
+
+			rawTime := salatTime.RawTime.Add(shift)
+
+			periodicAllSalatTimes[i].SalatTimes[j].RawTime = rawTime
+			periodicAllSalatTimes[i].SalatTimes[j].Time = opt.RoundTime(rawTime)
+		}
+	}
+
+	return periodicAllSalatTimes, nil
+}
@@ -0,0 +1,34 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+)
+
+func TestApparentSolarClockUnavailable(t *testing.T) {
+	date := time.Date(2024, time.December, 21, 0, 0, 0, 0, time.UTC)
+	opt := newTestOption(78., 15.6, time.UTC, date)
+
+	periodicAllSalatTimes, err := (&Schedule{}).ApparentSolarClock(opt)
+	if err != nil {
+		t.Fatalf("ApparentSolarClock() error = %v", err)
+	}
+
+	for _, salatTime := range periodicAllSalatTimes[0].SalatTimes {
+		if salatTime.Salat == salatEnum.Dhuhr {
+			if salatTime.Unavailable || salatTime.RawTime.Hour() != 12 {
+				t.Errorf("dhuhr raw time = %v, want around 12:00", salatTime.RawTime)
+			}
+
+			continue
+		}
+
+		if salatTime.Salat == salatEnum.Sunrise || salatTime.Salat == salatEnum.Sunset {
+			if !salatTime.Unavailable || !salatTime.Time.IsZero() || !salatTime.RawTime.IsZero() {
+				t.Errorf("%s = %v, %v, unavailable %v, want unavailable with the zero times", salatTime.Salat.Code(), salatTime.Time, salatTime.RawTime, salatTime.Unavailable)
+			}
+		}
+	}
+}
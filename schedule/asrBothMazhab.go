@@ -0,0 +1,39 @@
+package schedule
+
+import (
+	"time"
+
+	mazhabEnum "github.com/naufalfmm/moslem-salat-times/enum/mazhab"
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	"github.com/naufalfmm/moslem-salat-times/option"
+)
+
+// AsrBothMazhab returns the asr of the standard (shafi) and the hanafi shadow length on the same sun position of the date.
+// The mazhab of the option is not required.
+func (s *Schedule) AsrBothMazhab(opt option.Option, date time.Time) (time.Time, time.Time, error) {
+	if err := opt.ValidateBySalat(salatEnum.Dhuhr); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	dateOpt, err := opt.Clone().SetDateRange(date, date).CalculateSunPositions()
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	sunPosition := dateOpt.GetSunPositions()[0]
+
+	standardAsr := newSalatTime(dateOpt, sunPosition.Date, salatEnum.Asr, asrAngleTime(dateOpt.Clone().SetMazhab(mazhabEnum.Standard), sunPosition))
+	hanafiAsr := newSalatTime(dateOpt, sunPosition.Date, salatEnum.Asr, asrAngleTime(dateOpt.Clone().SetMazhab(mazhabEnum.Hanafi), sunPosition))
+
+	return standardAsr.Time, hanafiAsr.Time, nil
+}
+
+// AsrEnd returns the asr al-thani of the date, that is the later hanafi asr closing the window opened by the standard asr
+func (s *Schedule) AsrEnd(opt option.Option, date time.Time) (time.Time, error) {
+	_, hanafiAsr, err := s.AsrBothMazhab(opt, date)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return hanafiAsr, nil
+}
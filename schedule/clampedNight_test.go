@@ -0,0 +1,111 @@
+package schedule
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/naufalfmm/angle"
+	"github.com/naufalfmm/moslem-salat-times/err"
+)
+
+func TestClampedNight(t *testing.T) {
+	date := time.Date(2024, time.June, 21, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		fajrZenith       float64
+		ishaZenith       float64
+		minNightFraction float64
+		clamped          bool
+		undefined        bool
+	}{
+		{"short night widened", 2., 2., 0.15, true, false},
+		{"asymmetric short night widened", 1., 3., 0.15, true, false},
+		{"long enough night kept", 2., 2., 0.01, false, false},
+		{"no fraction", 2., 2., 0., false, false},
+		{"undefined fajr passed through", 18., 2., 0.15, false, true},
+		{"undefined isha passed through", 2., 18., 0.15, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opt, err := newTestOption(63., 10., time.UTC, date).
+				SetFajrIshaZenith(angle.NewDegreeFromFloat(tt.fajrZenith), angle.NewDegreeFromFloat(tt.ishaZenith)).
+				SetMinNightFraction(tt.minNightFraction).
+				CalculateSunPositions()
+			if err != nil {
+				t.Fatalf("CalculateSunPositions() error = %v", err)
+			}
+
+			sunPosition := opt.GetSunPositions()[0]
+			rawFajr, rawIsha := rawFajrAngleTime(opt, sunPosition), rawIshaAngleTime(opt, sunPosition)
+			fajr, isha := clampedNight(opt, sunPosition)
+
+			if undefined := isAngleUndefined(rawFajr) || isAngleUndefined(rawIsha); undefined != tt.undefined {
+				t.Fatalf("raw fajr %v and isha %v undefined = %v, want %v", rawFajr, rawIsha, undefined, tt.undefined)
+			}
+
+			if !tt.clamped {
+				if !sameAngleTime(fajr, rawFajr) || !sameAngleTime(isha, rawIsha) {
+					t.Errorf("clampedNight() = %v, %v, want the raw %v, %v", fajr, isha, rawFajr, rawIsha)
+				}
+
+				return
+			}
+
+			fajrHour, ishaHour := fajr.ToDegree().ToFloat(), isha.ToDegree().ToFloat()
+			rawFajrHour, rawIshaHour := rawFajr.ToDegree().ToFloat(), rawIsha.ToDegree().ToFloat()
+
+			if rawFajrHour+24.-rawIshaHour >= tt.minNightFraction*24. {
+				t.Fatalf("raw night = %v h, want shorter than %v h", rawFajrHour+24.-rawIshaHour, tt.minNightFraction*24.)
+			}
+
+			if night := fajrHour + 24. - ishaHour; math.Abs(night-tt.minNightFraction*24.) > 1e-9 {
+				t.Errorf("night = %v h, want %v h", night, tt.minNightFraction*24.)
+			}
+
+			if middle, rawMiddle := (fajrHour+24.+ishaHour)/2., (rawFajrHour+24.+rawIshaHour)/2.; math.Abs(middle-rawMiddle) > 1e-9 {
+				t.Errorf("middle of the night = %v h, want %v h", middle, rawMiddle)
+			}
+		})
+	}
+}
+
+func sameAngleTime(ang, want angle.Angle) bool {
+	if isAngleUndefined(want) {
+		return isAngleUndefined(ang)
+	}
+
+	return ang.ToDegree().ToFloat() == want.ToDegree().ToFloat()
+}
+
+func TestMinNightFractionOutOfRange(t *testing.T) {
+	date := time.Date(2024, time.June, 21, 0, 0, 0, 0, time.UTC)
+
+	for _, fraction := range []float64{-0.1, 1., 1.5} {
+		opt := newTestOption(63., 10., time.UTC, date).SetMinNightFraction(fraction)
+		if validateErr := opt.ValidateBySalat(0); !errors.Is(validateErr, err.ErrInvalidFraction) {
+			t.Errorf("SetMinNightFraction(%v) validation error = %v, want %v", fraction, validateErr, err.ErrInvalidFraction)
+		}
+
+		commOpt := CommOpt{}
+		WithMinNightFraction(fraction).Apply(&commOpt)
+		if _, calcErr := commOpt.CalculateSunPositions(); !errors.Is(calcErr, err.ErrInvalidFraction) {
+			t.Errorf("WithMinNightFraction(%v) error = %v, want %v", fraction, calcErr, err.ErrInvalidFraction)
+		}
+	}
+
+	for _, fraction := range []float64{0., 0.15} {
+		if validateErr := newTestOption(63., 10., time.UTC, date).SetMinNightFraction(fraction).ValidateBySalat(0); validateErr != nil {
+			t.Errorf("SetMinNightFraction(%v) validation error = %v", fraction, validateErr)
+		}
+
+		commOpt := CommOpt{}
+		WithMinNightFraction(fraction).Apply(&commOpt)
+		if commOpt.applyErr != nil || commOpt.minNightFraction != fraction {
+			t.Errorf("WithMinNightFraction(%v) = %v, %v, want the fraction kept", fraction, commOpt.minNightFraction, commOpt.applyErr)
+		}
+	}
+}
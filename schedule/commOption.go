@@ -6,11 +6,21 @@ import (
 
 	"github.com/naufalfmm/angle"
 	"github.com/naufalfmm/moslem-salat-times/consts"
+	accuracyModeEnum "github.com/naufalfmm/moslem-salat-times/enum/accuracyMode"
+	dhuhrDefinitionEnum "github.com/naufalfmm/moslem-salat-times/enum/dhuhrDefinition"
 	higherLatEnum "github.com/naufalfmm/moslem-salat-times/enum/higherLat"
+	longitudeConventionEnum "github.com/naufalfmm/moslem-salat-times/enum/longitudeConvention"
 	mazhabEnum "github.com/naufalfmm/moslem-salat-times/enum/mazhab"
 	periodicalEnum "github.com/naufalfmm/moslem-salat-times/enum/periodical"
 	roundingTimeOptionEnum "github.com/naufalfmm/moslem-salat-times/enum/roundingTimeOption"
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	shafaqEnum "github.com/naufalfmm/moslem-salat-times/enum/shafaq"
+	sunDiscEnum "github.com/naufalfmm/moslem-salat-times/enum/sunDisc"
 	sunZenithEnum "github.com/naufalfmm/moslem-salat-times/enum/sunZenith"
+	twilightEnum "github.com/naufalfmm/moslem-salat-times/enum/twilight"
+	"github.com/naufalfmm/moslem-salat-times/err"
+	"github.com/naufalfmm/moslem-salat-times/model"
+	"github.com/naufalfmm/moslem-salat-times/utils/gazetteer"
 	"github.com/naufalfmm/moslem-salat-times/utils/sunPositions"
 )
 
@@ -18,29 +28,97 @@ type CommOpt struct {
 	dateStart  time.Time
 	dateEnd    time.Time
 	periodical periodicalEnum.Periodical
+	weekStart  *time.Weekday
+	clock      func() time.Time
 
 	latitude    angle.Angle
 	longitude   angle.Angle
 	elevation   float64
 	timezoneLoc *time.Location
 
+	// latitudeSet and longitudeSet tell the explicit zero of the equator and the prime meridian apart from the unset coordinate
+	latitudeSet         bool
+	longitudeSet        bool
+	longitudeConvention longitudeConventionEnum.LongitudeConvention
+	// cityLongitude marks the longitude of the gazetteer city, which is east positive regardless of the convention
+	cityLongitude bool
+
+	qiblaReference *model.Coordinate
+
 	fajrZenith     angle.Angle
 	ishaZenith     angle.Angle
 	ishaZenithType sunZenithEnum.IshaZenithType
+	maghribZenith  angle.Angle
+
+	ishaIgnoresElevation  bool
+	shafaqType            shafaqEnum.Shafaq
+	useGeocentricLatitude bool
+
+	sunriseSunsetZenith *angle.Angle
+	sunDiscReference    sunDiscEnum.SunDisc
+	dhuhrDefinition     dhuhrDefinitionEnum.DhuhrDefinition
 
 	mazhab               mazhabEnum.Mazhab
 	higherLatitudeMethod higherLatEnum.HigherLat
+	nearestLatitude      *angle.Angle
 
 	roundingTimeOption roundingTimeOptionEnum.RoundingTimeOption
+	globalOffset       time.Duration
+	accuracyMode       accuracyModeEnum.AccuracyMode
+	solarOverride      *sunPositions.SolarOverride
+	ephemeris          sunPositions.Ephemeris
+
+	makruhAfterSunrise time.Duration
+	makruhBeforeSunset time.Duration
+	zawalWidth         time.Duration
+	minNightFraction   float64
+	tahajjudUntilFajr  bool
+
+	excludedDates map[time.Time]bool
+	specialDates  map[time.Time]model.SpecialHandling
+
+	salats []salatEnum.Salat
 
 	sunPositions sunPositions.SunPositions
+
+	// applyErr keeps the error of the applied option, e.g. the unknown city, until the option is validated
+	applyErr error
 }
 
 func (c CommOpt) ToOption() Option {
 	return Option(c)
 }
 
+func (c *CommOpt) now() time.Time {
+	if c.clock == nil {
+		return time.Now()
+	}
+
+	return c.clock()
+}
+
+func (c *CommOpt) periodicalDateRange(date time.Time, periodical periodicalEnum.Periodical) (time.Time, time.Time) {
+	if c.weekStart == nil {
+		return periodical.GetDateRange(date)
+	}
+
+	return periodical.GetDateRangeByWeekStart(date, *c.weekStart)
+}
+
+// eastLongitude returns the longitude kept as given normalized into the east positive longitude by the convention
+func (c *CommOpt) eastLongitude() angle.Angle {
+	if c.cityLongitude {
+		return c.longitude
+	}
+
+	return toEastPositive(c.longitude, c.longitudeConvention)
+}
+
 func (c *CommOpt) CalculateSunPositions() (CommOpt, error) {
+	if c.applyErr != nil {
+		return CommOpt{}, c.applyErr
+	}
+
 	if len(c.sunPositions) > 0 {
 		return *c, nil
 	}
@@ -58,7 +136,22 @@ func (c *CommOpt) CalculateSunPositions() (CommOpt, error) {
 		c.timezoneLoc = c.dateStart.Location()
 	}
 
-	c.sunPositions = sunPositions.NewFromDateRange(c.dateStart, c.dateEnd, c.timezoneLoc, c.longitude)
+	if c.solarOverride != nil {
+		c.sunPositions = sunPositions.NewFromOverride(c.dateStart, c.dateEnd, c.timezoneLoc, c.eastLongitude(), *c.solarOverride)
+		return *c, nil
+	}
+
+	if c.ephemeris != nil {
+		sunPoss, err := sunPositions.NewFromEphemeris(c.dateStart, c.dateEnd, c.timezoneLoc, c.eastLongitude(), c.ephemeris)
+		if err != nil {
+			return CommOpt{}, err
+		}
+
+		c.sunPositions = sunPoss
+		return *c, nil
+	}
+
+	c.sunPositions = sunPositions.NewFromDateRange(c.dateStart, c.dateEnd, c.timezoneLoc, c.eastLongitude(), c.accuracyMode)
 	return *c, nil
 }
 
@@ -69,7 +162,7 @@ type ApplyCommOpt interface {
 type setNow struct{}
 
 func (s setNow) Apply(o *CommOpt) {
-	o.dateStart = time.Now()
+	o.dateStart = o.now()
 	o.dateEnd = o.dateStart
 	o.periodical = periodicalEnum.Custom
 }
@@ -78,6 +171,20 @@ func SetNow() ApplyCommOpt {
 	return setNow{}
 }
 
+type withClock struct {
+	clock func() time.Time
+}
+
+func (w withClock) Apply(o *CommOpt) {
+	o.clock = w.clock
+}
+
+func WithClock(clock func() time.Time) ApplyCommOpt {
+	return withClock{
+		clock: clock,
+	}
+}
+
 type withDateRange struct {
 	dateStart, dateEnd time.Time
 }
@@ -95,14 +202,14 @@ type withPeriodical struct {
 func (w withPeriodical) Apply(o *CommOpt) {
 	date := o.dateStart
 	if date.IsZero() {
-		date = time.Now()
+		date = o.now()
 
 		if o.timezoneLoc != nil {
 			date = date.In(o.timezoneLoc)
 		}
 	}
 
-	o.dateStart, o.dateEnd = w.periodical.GetDateRange(date)
+	o.dateStart, o.dateEnd = o.periodicalDateRange(date, w.periodical)
 	o.periodical = w.periodical
 }
 
@@ -112,6 +219,24 @@ func WithPeriodical(periodical periodicalEnum.Periodical) ApplyCommOpt {
 	}
 }
 
+type withWeekStart struct {
+	weekStart time.Weekday
+}
+
+func (w withWeekStart) Apply(o *CommOpt) {
+	o.weekStart = &w.weekStart
+
+	if o.periodical == periodicalEnum.Weekly {
+		o.dateStart, o.dateEnd = o.periodicalDateRange(o.dateStart, o.periodical)
+	}
+}
+
+func WithWeekStart(weekStart time.Weekday) ApplyCommOpt {
+	return withWeekStart{
+		weekStart: weekStart,
+	}
+}
+
 type withLatitudeLongitude struct {
 	latitude  angle.Angle
 	longitude angle.Angle
@@ -120,6 +245,9 @@ type withLatitudeLongitude struct {
 func (w withLatitudeLongitude) Apply(o *CommOpt) {
 	o.latitude = w.latitude
 	o.longitude = w.longitude
+	o.cityLongitude = false
+	o.latitudeSet = true
+	o.longitudeSet = true
 }
 
 func WithLatitudeLongitude(lat, long angle.Angle) ApplyCommOpt {
@@ -129,6 +257,53 @@ func WithLatitudeLongitude(lat, long angle.Angle) ApplyCommOpt {
 	}
 }
 
+type withLongitudeConvention struct {
+	convention longitudeConventionEnum.LongitudeConvention
+}
+
+func (w withLongitudeConvention) Apply(o *CommOpt) {
+	o.longitudeConvention = w.convention
+}
+
+// WithLongitudeConvention takes the longitude of WithLatitudeLongitude in the convention, applied before or after it
+func WithLongitudeConvention(convention longitudeConventionEnum.LongitudeConvention) ApplyCommOpt {
+	return withLongitudeConvention{
+		convention: convention,
+	}
+}
+
+type withCity struct {
+	name string
+}
+
+// Apply keeps the error of the city lookup or the timezone loading in applyErr, so the validation reports it
+func (w withCity) Apply(o *CommOpt) {
+	city, cityErr := gazetteer.Lookup(w.name)
+	if cityErr != nil {
+		o.applyErr = cityErr
+		return
+	}
+
+	timezoneLoc, locErr := time.LoadLocation(city.Timezone)
+	if locErr != nil {
+		o.applyErr = locErr
+		return
+	}
+
+	o.latitude = city.Latitude
+	o.longitude = city.Longitude
+	o.cityLongitude = true
+	o.latitudeSet = true
+	o.longitudeSet = true
+	o.timezoneLoc = timezoneLoc
+}
+
+func WithCity(name string) ApplyCommOpt {
+	return withCity{
+		name: name,
+	}
+}
+
 type withTimezoneOffset struct {
 	timezoneOffset float64
 }
@@ -150,6 +325,20 @@ func WithTimezoneOffset(timezoneOffset float64) ApplyCommOpt {
 	}
 }
 
+type withTimezoneOffsetSeconds struct {
+	timezoneOffsetSeconds int
+}
+
+func (w withTimezoneOffsetSeconds) Apply(o *CommOpt) {
+	o.timezoneLoc = timezoneOffsetSecondsZone(w.timezoneOffsetSeconds)
+}
+
+func WithTimezoneOffsetSeconds(timezoneOffsetSeconds int) ApplyCommOpt {
+	return withTimezoneOffsetSeconds{
+		timezoneOffsetSeconds: timezoneOffsetSeconds,
+	}
+}
+
 type withTimezone struct {
 	timezone *time.Location
 }
@@ -196,6 +385,31 @@ func WithFajrIshaZenith(fajrZenith, ishaZenith angle.Angle) ApplyCommOpt {
 	}
 }
 
+func WithSymmetricTwilight(zenith angle.Angle) ApplyCommOpt {
+	return withFajrIshaZenith{
+		fajrZenith: zenith,
+		ishaZenith: zenith,
+	}
+}
+
+type withTwilightConvention struct {
+	fajr twilightEnum.Twilight
+	isha twilightEnum.Twilight
+}
+
+func (w withTwilightConvention) Apply(o *CommOpt) {
+	o.fajrZenith = angle.NewDegreeFromFloat(w.fajr.Depression())
+	o.ishaZenith = angle.NewDegreeFromFloat(w.isha.Depression())
+	o.ishaZenithType = sunZenithEnum.Standard
+}
+
+func WithTwilightConvention(fajr, isha twilightEnum.Twilight) ApplyCommOpt {
+	return withTwilightConvention{
+		fajr: fajr,
+		isha: isha,
+	}
+}
+
 type withSunZenith struct {
 	sunZenith sunZenithEnum.SunZenith
 }
@@ -204,6 +418,7 @@ func (w withSunZenith) Apply(o *CommOpt) {
 	o.fajrZenith = w.sunZenith.FajrZenith()
 	o.ishaZenith = w.sunZenith.IshaZenith().Angle
 	o.ishaZenithType = w.sunZenith.IshaZenith().Type
+	o.maghribZenith = w.sunZenith.MaghribZenith()
 }
 
 func WithSunZenith(sunZenith sunZenithEnum.SunZenith) ApplyCommOpt {
@@ -212,6 +427,62 @@ func WithSunZenith(sunZenith sunZenithEnum.SunZenith) ApplyCommOpt {
 	}
 }
 
+type withMaghribZenith struct {
+	maghribZenith angle.Angle
+}
+
+func (w withMaghribZenith) Apply(o *CommOpt) {
+	o.maghribZenith = w.maghribZenith
+}
+
+func WithMaghribZenith(maghribZenith angle.Angle) ApplyCommOpt {
+	return withMaghribZenith{
+		maghribZenith: maghribZenith,
+	}
+}
+
+type withSunriseSunsetZenith struct {
+	sunriseSunsetZenith angle.Angle
+}
+
+func (w withSunriseSunsetZenith) Apply(o *CommOpt) {
+	o.sunriseSunsetZenith = &w.sunriseSunsetZenith
+}
+
+func WithSunriseSunsetZenith(sunriseSunsetZenith angle.Angle) ApplyCommOpt {
+	return withSunriseSunsetZenith{
+		sunriseSunsetZenith: sunriseSunsetZenith,
+	}
+}
+
+type withDhuhrDefinition struct {
+	dhuhrDefinition dhuhrDefinitionEnum.DhuhrDefinition
+}
+
+func (w withDhuhrDefinition) Apply(o *CommOpt) {
+	o.dhuhrDefinition = w.dhuhrDefinition
+}
+
+func WithDhuhrDefinition(dhuhrDefinition dhuhrDefinitionEnum.DhuhrDefinition) ApplyCommOpt {
+	return withDhuhrDefinition{
+		dhuhrDefinition: dhuhrDefinition,
+	}
+}
+
+type withSunDiscReference struct {
+	sunDiscReference sunDiscEnum.SunDisc
+}
+
+func (w withSunDiscReference) Apply(o *CommOpt) {
+	o.sunDiscReference = w.sunDiscReference
+}
+
+func WithSunDiscReference(sunDiscReference sunDiscEnum.SunDisc) ApplyCommOpt {
+	return withSunDiscReference{
+		sunDiscReference: sunDiscReference,
+	}
+}
+
 type withMazhab struct {
 	mazhab mazhabEnum.Mazhab
 }
@@ -240,6 +511,69 @@ func WithRoundingTimeOption(roundingTimeOpt roundingTimeOptionEnum.RoundingTimeO
 	}
 }
 
+type withGlobalOffset struct {
+	offset time.Duration
+}
+
+func (w withGlobalOffset) Apply(o *CommOpt) {
+	o.globalOffset = w.offset
+}
+
+func WithGlobalOffset(offset time.Duration) ApplyCommOpt {
+	return withGlobalOffset{
+		offset: offset,
+	}
+}
+
+type withAccuracyMode struct {
+	accuracyMode accuracyModeEnum.AccuracyMode
+}
+
+func (w withAccuracyMode) Apply(o *CommOpt) {
+	o.accuracyMode = w.accuracyMode
+}
+
+func WithAccuracyMode(accuracyMode accuracyModeEnum.AccuracyMode) ApplyCommOpt {
+	return withAccuracyMode{
+		accuracyMode: accuracyMode,
+	}
+}
+
+type withSolarOverride struct {
+	declination    angle.Angle
+	equationOfTime time.Duration
+}
+
+func (w withSolarOverride) Apply(o *CommOpt) {
+	o.solarOverride = &sunPositions.SolarOverride{
+		Declination:    w.declination,
+		EquationOfTime: w.equationOfTime,
+	}
+	o.ephemeris = nil
+}
+
+func WithSolarOverride(declination angle.Angle, equationOfTime time.Duration) ApplyCommOpt {
+	return withSolarOverride{
+		declination:    declination,
+		equationOfTime: equationOfTime,
+	}
+}
+
+type withEphemeris struct {
+	ephemeris sunPositions.Ephemeris
+}
+
+func (w withEphemeris) Apply(o *CommOpt) {
+	o.ephemeris = w.ephemeris
+	o.solarOverride = nil
+}
+
+func WithEphemeris(ephemeris func(date time.Time) (angle.Angle, time.Duration, error)) ApplyCommOpt {
+	return withEphemeris{
+		ephemeris: ephemeris,
+	}
+}
+
 type withHigherLatitudeMethod struct {
 	higherLatMethod higherLatEnum.HigherLat
 }
@@ -253,3 +587,186 @@ func WithHigherLatitudeMethod(higherLatMethod higherLatEnum.HigherLat) ApplyComm
 		higherLatMethod: higherLatMethod,
 	}
 }
+
+type withNearestLatitude struct {
+	referenceLatitude angle.Angle
+}
+
+func (w withNearestLatitude) Apply(o *CommOpt) {
+	o.higherLatitudeMethod = higherLatEnum.NearestLatitude
+	o.nearestLatitude = &w.referenceLatitude
+}
+
+func WithNearestLatitude(referenceLatitude angle.Angle) ApplyCommOpt {
+	return withNearestLatitude{
+		referenceLatitude: referenceLatitude,
+	}
+}
+
+type withSalats struct {
+	salats []salatEnum.Salat
+}
+
+func (w withSalats) Apply(o *CommOpt) {
+	o.salats = w.salats
+}
+
+func WithSalats(salats ...salatEnum.Salat) ApplyCommOpt {
+	return withSalats{
+		salats: salats,
+	}
+}
+
+type withMakruhWidths struct {
+	afterSunrise time.Duration
+	beforeSunset time.Duration
+}
+
+func (w withMakruhWidths) Apply(o *CommOpt) {
+	o.makruhAfterSunrise = w.afterSunrise
+	o.makruhBeforeSunset = w.beforeSunset
+}
+
+func WithMakruhWidths(afterSunrise, beforeSunset time.Duration) ApplyCommOpt {
+	return withMakruhWidths{
+		afterSunrise: afterSunrise,
+		beforeSunset: beforeSunset,
+	}
+}
+
+type withZawalWidth struct {
+	width time.Duration
+}
+
+func (w withZawalWidth) Apply(o *CommOpt) {
+	o.zawalWidth = w.width
+}
+
+func WithZawalWidth(width time.Duration) ApplyCommOpt {
+	return withZawalWidth{
+		width: width,
+	}
+}
+
+type withMinNightFraction struct {
+	fraction float64
+}
+
+// Apply keeps the fraction out of [0, 1) as the error in applyErr, the same as SetMinNightFraction
+func (w withMinNightFraction) Apply(o *CommOpt) {
+	if !isValidMinNightFraction(w.fraction) {
+		o.applyErr = err.ErrInvalidFraction
+		return
+	}
+
+	o.minNightFraction = w.fraction
+}
+
+func WithMinNightFraction(fraction float64) ApplyCommOpt {
+	return withMinNightFraction{
+		fraction: fraction,
+	}
+}
+
+type withExcludedDates struct {
+	dates []time.Time
+}
+
+func (w withExcludedDates) Apply(o *CommOpt) {
+	o.excludedDates = calendarDateSet(w.dates)
+}
+
+func WithExcludedDates(dates []time.Time) ApplyCommOpt {
+	return withExcludedDates{
+		dates: dates,
+	}
+}
+
+type withSpecialDates struct {
+	specialDates map[time.Time]model.SpecialHandling
+}
+
+func (w withSpecialDates) Apply(o *CommOpt) {
+	o.specialDates = calendarDateSpecials(w.specialDates)
+}
+
+func WithSpecialDates(specialDates map[time.Time]model.SpecialHandling) ApplyCommOpt {
+	return withSpecialDates{
+		specialDates: specialDates,
+	}
+}
+
+type withTahajjudUntilFajr struct {
+	untilFajr bool
+}
+
+func (w withTahajjudUntilFajr) Apply(o *CommOpt) {
+	o.tahajjudUntilFajr = w.untilFajr
+}
+
+func WithTahajjudUntilFajr(untilFajr bool) ApplyCommOpt {
+	return withTahajjudUntilFajr{
+		untilFajr: untilFajr,
+	}
+}
+
+type withQiblaReference struct {
+	latitude  angle.Angle
+	longitude angle.Angle
+}
+
+func (w withQiblaReference) Apply(o *CommOpt) {
+	o.qiblaReference = &model.Coordinate{
+		Latitude:  w.latitude,
+		Longitude: w.longitude,
+	}
+}
+
+func WithQiblaReference(latitude, longitude angle.Angle) ApplyCommOpt {
+	return withQiblaReference{
+		latitude:  latitude,
+		longitude: longitude,
+	}
+}
+
+type withIshaIgnoresElevation struct {
+	ignore bool
+}
+
+func (w withIshaIgnoresElevation) Apply(o *CommOpt) {
+	o.ishaIgnoresElevation = w.ignore
+}
+
+func WithIshaIgnoresElevation(ignore bool) ApplyCommOpt {
+	return withIshaIgnoresElevation{
+		ignore: ignore,
+	}
+}
+
+type withShafaqType struct {
+	shafaqType shafaqEnum.Shafaq
+}
+
+func (w withShafaqType) Apply(o *CommOpt) {
+	o.shafaqType = w.shafaqType
+}
+
+func WithShafaqType(shafaqType shafaqEnum.Shafaq) ApplyCommOpt {
+	return withShafaqType{
+		shafaqType: shafaqType,
+	}
+}
+
+type withUseGeocentricLatitude struct {
+	useGeocentricLatitude bool
+}
+
+func (w withUseGeocentricLatitude) Apply(o *CommOpt) {
+	o.useGeocentricLatitude = w.useGeocentricLatitude
+}
+
+func WithUseGeocentricLatitude(useGeocentricLatitude bool) ApplyCommOpt {
+	return withUseGeocentricLatitude{
+		useGeocentricLatitude: useGeocentricLatitude,
+	}
+}
@@ -0,0 +1,64 @@
+package schedule
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/naufalfmm/moslem-salat-times/err"
+)
+
+func TestWithCityUnknownCity(t *testing.T) {
+	commOpt := CommOpt{}
+	WithCity("Typo").Apply(&commOpt)
+
+	if _, calcErr := commOpt.CalculateSunPositions(); !errors.Is(calcErr, err.ErrUnknownCity) {
+		t.Errorf("CalculateSunPositions() error = %v, want %v", calcErr, err.ErrUnknownCity)
+	}
+
+	opt := commOpt.ToOption()
+	if validateErr := opt.ValidateBySalat(0); !errors.Is(validateErr, err.ErrUnknownCity) {
+		t.Errorf("ValidateBySalat() error = %v, want %v", validateErr, err.ErrUnknownCity)
+	}
+}
+
+func TestWithCity(t *testing.T) {
+	tests := []struct {
+		name      string
+		latitude  float64
+		longitude float64
+		timezone  string
+	}{
+		{"Jakarta", -6.2088, 106.8456, "Asia/Jakarta"},
+		{"Mecca", 21.4225, 39.8262, "Asia/Riyadh"},
+		{"mecca", 21.4225, 39.8262, "Asia/Riyadh"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commOpt := CommOpt{}
+			WithCity(tt.name).Apply(&commOpt)
+
+			if commOpt.applyErr != nil {
+				t.Fatalf("WithCity() error = %v", commOpt.applyErr)
+			}
+
+			opt, setErr := (&Option{}).SetCity(tt.name)
+			if setErr != nil {
+				t.Fatalf("SetCity() error = %v", setErr)
+			}
+
+			for optName, got := range map[string]struct {
+				latitude  float64
+				longitude float64
+				timezone  string
+			}{
+				"WithCity": {commOpt.latitude.ToDegree().ToFloat(), commOpt.longitude.ToDegree().ToFloat(), commOpt.timezoneLoc.String()},
+				"SetCity":  {opt.(*Option).latitude.ToDegree().ToFloat(), opt.GetLongitude().ToDegree().ToFloat(), opt.GetTimezone().String()},
+			} {
+				if got.latitude != tt.latitude || got.longitude != tt.longitude || got.timezone != tt.timezone {
+					t.Errorf("%s() = %v, %v, %s, want %v, %v, %s", optName, got.latitude, got.longitude, got.timezone, tt.latitude, tt.longitude, tt.timezone)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package schedule
+
+import (
+	"time"
+
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	"github.com/naufalfmm/moslem-salat-times/err"
+	"github.com/naufalfmm/moslem-salat-times/option"
+	"github.com/naufalfmm/moslem-salat-times/utils/sunPositions"
+)
+
+func daylightLength(opt option.Option, sunPos sunPositions.SunPosition) (time.Duration, error) {
+	halfDaylight := opt.CalculateSunriseSunsetHighAltitude(sunPos.Declination)
+	if isAngleUndefined(halfDaylight) {
+		return 0, err.ErrDaylightUndefined
+	}
+
+	return time.Duration(2. * halfDaylight.ToDegree().ToFloat() * float64(time.Hour)), nil
+}
+
+func (s *Schedule) DaylightDelta(opt option.Option, date time.Time) (time.Duration, error) {
+	if err := opt.ValidateBySalat(salatEnum.Sunrise); err != nil {
+		return 0, err
+	}
+
+	dateOpt, err := opt.Clone().SetDateRange(date.AddDate(0, 0, -1), date).CalculateSunPositions()
+	if err != nil {
+		return 0, err
+	}
+
+	sunPoss := dateOpt.GetSunPositions()
+
+	prevDaylight, err := daylightLength(dateOpt, sunPoss[0])
+	if err != nil {
+		return 0, err
+	}
+
+	daylight, err := daylightLength(dateOpt, sunPoss[len(sunPoss)-1])
+	if err != nil {
+		return 0, err
+	}
+
+	return daylight - prevDaylight, nil
+}
@@ -0,0 +1,81 @@
+package schedule
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/naufalfmm/moslem-salat-times/err"
+)
+
+func TestDaylightDeltaSolsticeAndEquinox(t *testing.T) {
+	opt := newTestOption(50., 0., time.UTC, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))
+
+	solsticeDelta, calcErr := (&Schedule{}).DaylightDelta(opt, time.Date(2024, time.June, 20, 0, 0, 0, 0, time.UTC))
+	if calcErr != nil {
+		t.Fatalf("DaylightDelta() error = %v", calcErr)
+	}
+
+	if solsticeDelta < -5*time.Second || solsticeDelta > 5*time.Second {
+		t.Errorf("DaylightDelta() at the june solstice = %v, want about 0", solsticeDelta)
+	}
+
+	var maxDelta time.Duration
+	var maxDate time.Time
+	for date := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC); date.Year() == 2024; date = date.AddDate(0, 0, 1) {
+		delta, calcErr := (&Schedule{}).DaylightDelta(opt, date)
+		if calcErr != nil {
+			t.Fatalf("DaylightDelta() on %v error = %v", date, calcErr)
+		}
+
+		if delta > maxDelta {
+			maxDelta, maxDate = delta, date
+		}
+	}
+
+	if equinox := time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC); maxDate.Before(equinox.AddDate(0, 0, -10)) || maxDate.After(equinox.AddDate(0, 0, 10)) {
+		t.Errorf("DaylightDelta() is the largest on %v, want near the march equinox", maxDate)
+	}
+
+	if maxDelta < 3*time.Minute || maxDelta > 4*time.Minute {
+		t.Errorf("DaylightDelta() maximum = %v, want about 3m40s", maxDelta)
+	}
+}
+
+func TestDaylightDeltaDSTTransition(t *testing.T) {
+	newYork, loadErr := time.LoadLocation("America/New_York")
+	if loadErr != nil {
+		t.Skipf("LoadLocation() error = %v", loadErr)
+	}
+
+	opt := newTestOption(40.7128, -74.006, newYork, time.Date(2024, time.March, 1, 0, 0, 0, 0, newYork))
+
+	deltas := make([]time.Duration, 3)
+	for i := range deltas {
+		delta, calcErr := (&Schedule{}).DaylightDelta(opt, time.Date(2024, time.March, 9+i, 0, 0, 0, 0, newYork))
+		if calcErr != nil {
+			t.Fatalf("DaylightDelta() error = %v", calcErr)
+		}
+
+		deltas[i] = delta
+	}
+
+	for i, delta := range deltas {
+		if delta < 2*time.Minute || delta > 3*time.Minute {
+			t.Errorf("DaylightDelta() on march %d = %v, want about 2m30s", 9+i, delta)
+		}
+	}
+
+	// the sun positions are taken at the local noon, which is an hour closer across the transition
+	if diff := deltas[1] - deltas[0]; diff < -10*time.Second || diff > 10*time.Second {
+		t.Errorf("DaylightDelta() on the transition = %v, want close to the day before %v", deltas[1], deltas[0])
+	}
+}
+
+func TestDaylightDeltaPolarDay(t *testing.T) {
+	date := time.Date(2024, time.June, 21, 0, 0, 0, 0, time.UTC)
+
+	if _, calcErr := (&Schedule{}).DaylightDelta(newTestOption(75., 0., time.UTC, date), date); !errors.Is(calcErr, err.ErrDaylightUndefined) {
+		t.Errorf("DaylightDelta() on the polar day error = %v, want %v", calcErr, err.ErrDaylightUndefined)
+	}
+}
@@ -0,0 +1,109 @@
+package schedule
+
+import (
+	accuracyModeEnum "github.com/naufalfmm/moslem-salat-times/enum/accuracyMode"
+	dhuhrDefinitionEnum "github.com/naufalfmm/moslem-salat-times/enum/dhuhrDefinition"
+	higherLatEnum "github.com/naufalfmm/moslem-salat-times/enum/higherLat"
+	longitudeConventionEnum "github.com/naufalfmm/moslem-salat-times/enum/longitudeConvention"
+	mazhabEnum "github.com/naufalfmm/moslem-salat-times/enum/mazhab"
+	periodicalEnum "github.com/naufalfmm/moslem-salat-times/enum/periodical"
+	roundingTimeOptionEnum "github.com/naufalfmm/moslem-salat-times/enum/roundingTimeOption"
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	shafaqEnum "github.com/naufalfmm/moslem-salat-times/enum/shafaq"
+	sunDiscEnum "github.com/naufalfmm/moslem-salat-times/enum/sunDisc"
+	sunZenithEnum "github.com/naufalfmm/moslem-salat-times/enum/sunZenith"
+	twilightEnum "github.com/naufalfmm/moslem-salat-times/enum/twilight"
+	"github.com/naufalfmm/moslem-salat-times/model"
+)
+
+const (
+	fieldTypeAngle    = "angle"
+	fieldTypeNumber   = "number"
+	fieldTypeInteger  = "integer"
+	fieldTypeBoolean  = "boolean"
+	fieldTypeDuration = "duration"
+	fieldTypeString   = "string"
+	fieldTypeEnum     = "enum"
+	fieldTypeEnumList = "enumList"
+
+	fieldUnitDegree = "degree"
+	fieldUnitMeter  = "meter"
+	fieldUnitHour   = "hour"
+	fieldUnitSecond = "second"
+)
+
+// enumCodes lists the codes of the enum starting from 1 until the code is empty
+func enumCodes(code func(i int) string) []string {
+	codes := []string{}
+	for i := 1; code(i) != ""; i++ {
+		codes = append(codes, code(i))
+	}
+
+	return codes
+}
+
+func rangedField(name, fieldType, unit string, minimum, maximum float64) model.FieldDescriptor {
+	return model.FieldDescriptor{
+		Name:    name,
+		Type:    fieldType,
+		Unit:    unit,
+		Minimum: &minimum,
+		Maximum: &maximum,
+	}
+}
+
+func enumField(name, fieldType string, code func(i int) string) model.FieldDescriptor {
+	return model.FieldDescriptor{
+		Name:   name,
+		Type:   fieldType,
+		Values: enumCodes(code),
+	}
+}
+
+// Describe lists the configurable fields of the option with their type, unit, and valid range or enum codes.
+// The zenith angles are the depression of the sun below the horizon.
+func (o *Option) Describe() model.FieldDescriptors {
+	return model.FieldDescriptors{
+		rangedField("latitude", fieldTypeAngle, fieldUnitDegree, -90., 90.),
+		rangedField("longitude", fieldTypeAngle, fieldUnitDegree, -180., 180.),
+		enumField("longitudeConvention", fieldTypeEnum, func(i int) string { return longitudeConventionEnum.LongitudeConvention(i).Code() }),
+		{Name: "city", Type: fieldTypeString},
+		rangedField("qiblaReferenceLatitude", fieldTypeAngle, fieldUnitDegree, -90., 90.),
+		rangedField("qiblaReferenceLongitude", fieldTypeAngle, fieldUnitDegree, -180., 180.),
+		{Name: "elevation", Type: fieldTypeNumber, Unit: fieldUnitMeter, Minimum: new(float64)},
+
+		{Name: "timezone", Type: fieldTypeString},
+		rangedField("timezoneOffset", fieldTypeNumber, fieldUnitHour, -12., 14.),
+		rangedField("timezoneOffsetSeconds", fieldTypeInteger, fieldUnitSecond, -12.*3600., 14.*3600.),
+
+		enumField("periodical", fieldTypeEnum, func(i int) string { return periodicalEnum.Periodical(i).Code() }),
+		rangedField("weekStart", fieldTypeInteger, "", 0., 6.),
+		enumField("salats", fieldTypeEnumList, func(i int) string { return salatEnum.Salat(i).Code() }),
+
+		enumField("mazhab", fieldTypeEnum, func(i int) string { return mazhabEnum.Mazhab(i).Code() }),
+		enumField("sunZenith", fieldTypeEnum, func(i int) string { return sunZenithEnum.SunZenith(i).Code() }),
+		enumField("fajrTwilight", fieldTypeEnum, func(i int) string { return twilightEnum.Twilight(i).Code() }),
+		enumField("ishaTwilight", fieldTypeEnum, func(i int) string { return twilightEnum.Twilight(i).Code() }),
+		rangedField("fajrZenith", fieldTypeAngle, fieldUnitDegree, 0., 90.),
+		rangedField("ishaZenith", fieldTypeAngle, fieldUnitDegree, 0., 90.),
+		rangedField("maghribZenith", fieldTypeAngle, fieldUnitDegree, 0., 90.),
+		rangedField("sunriseSunsetZenith", fieldTypeAngle, fieldUnitDegree, 0., 90.),
+		enumField("sunDiscReference", fieldTypeEnum, func(i int) string { return sunDiscEnum.SunDisc(i).Code() }),
+		enumField("dhuhrDefinition", fieldTypeEnum, func(i int) string { return dhuhrDefinitionEnum.DhuhrDefinition(i).Code() }),
+		enumField("shafaqType", fieldTypeEnum, func(i int) string { return shafaqEnum.Shafaq(i).Code() }),
+		{Name: "ishaIgnoresElevation", Type: fieldTypeBoolean},
+		{Name: "useGeocentricLatitude", Type: fieldTypeBoolean},
+
+		enumField("higherLatitudeMethod", fieldTypeEnum, func(i int) string { return higherLatEnum.HigherLat(i).Code() }),
+		rangedField("nearestLatitude", fieldTypeAngle, fieldUnitDegree, 0., 90.),
+		rangedField("minNightFraction", fieldTypeNumber, "", 0., 1.),
+		{Name: "tahajjudUntilFajr", Type: fieldTypeBoolean},
+
+		enumField("roundingTimeOption", fieldTypeEnum, func(i int) string { return roundingTimeOptionEnum.RoundingTimeOption(i).Code() }),
+		{Name: "globalOffset", Type: fieldTypeDuration},
+		enumField("accuracyMode", fieldTypeEnum, func(i int) string { return accuracyModeEnum.AccuracyMode(i).Code() }),
+		{Name: "makruhAfterSunrise", Type: fieldTypeDuration},
+		{Name: "makruhBeforeSunset", Type: fieldTypeDuration},
+		{Name: "zawalWidth", Type: fieldTypeDuration},
+	}
+}
@@ -0,0 +1,70 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/naufalfmm/angle"
+	"github.com/naufalfmm/moslem-salat-times/err"
+	"github.com/naufalfmm/moslem-salat-times/model"
+	"github.com/naufalfmm/moslem-salat-times/option"
+	"github.com/naufalfmm/moslem-salat-times/utils/coordinate"
+)
+
+func isValidCoordinate(pos model.Coordinate) bool {
+	return coordinate.IsValidLatitude(pos.Latitude) && coordinate.IsValidLongitude(pos.Longitude)
+}
+
+func interpolateDegree(start, end angle.Angle, fraction float64) angle.Angle {
+	startDeg := start.ToDegree().ToFloat()
+
+	return angle.NewDegreeFromFloat(startDeg + (end.ToDegree().ToFloat()-startDeg)*fraction)
+}
+
+// interpolateLongitude moves along the shorter way, so the journey crossing the antimeridian does not go around the globe
+func interpolateLongitude(start, end angle.Angle, fraction float64) angle.Angle {
+	startDeg := start.ToDegree().ToFloat()
+
+	return angle.NewDegreeFromFloat(signedDegree(startDeg + signedDegree(end.ToDegree().ToFloat()-startDeg)*fraction))
+}
+
+// TimesEnRoute returns the salat times of the date experienced at each sample of the journey.
+// The position and time of the observer are linearly interpolated between the start and the end, both included, while the timezone of the option is kept.
+func (s *Schedule) TimesEnRoute(opt option.Option, startPos, endPos model.Coordinate, startTime, endTime time.Time, samples int) (model.PeriodicAllSalatTime, error) {
+	if samples < 1 {
+		return nil, err.ErrInvalidSamples
+	}
+
+	if !isValidCoordinate(startPos) || !isValidCoordinate(endPos) {
+		return nil, err.ErrInvalidCoordinate
+	}
+
+	loc := opt.GetTimezone()
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	journey := endTime.Sub(startTime)
+
+	periodicAllSalatTimes := make(model.PeriodicAllSalatTime, samples)
+	for i := range periodicAllSalatTimes {
+		fraction := 0.
+		if samples > 1 {
+			fraction = float64(i) / float64(samples-1)
+		}
+
+		date := startTime.Add(time.Duration(float64(journey) * fraction)).In(loc)
+
+		sampleOpt := opt.Clone().
+			SetLatitudeLongitude(interpolateDegree(startPos.Latitude, endPos.Latitude, fraction), interpolateLongitude(startPos.Longitude, endPos.Longitude, fraction)).
+			SetDateRange(date, date)
+
+		allSalatTimes, calcErr := s.allTimes(sampleOpt)
+		if calcErr != nil {
+			return nil, calcErr
+		}
+
+		periodicAllSalatTimes[i] = allSalatTimes[0]
+	}
+
+	return periodicAllSalatTimes, nil
+}
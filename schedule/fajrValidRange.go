@@ -0,0 +1,77 @@
+package schedule
+
+import (
+	"time"
+
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	"github.com/naufalfmm/moslem-salat-times/err"
+	"github.com/naufalfmm/moslem-salat-times/option"
+	"github.com/naufalfmm/moslem-salat-times/utils/sunPositions"
+)
+
+func yearOption(opt option.Option, year int) (option.Option, error) {
+	loc := opt.GetTimezone()
+
+	return opt.Clone().
+		SetDateRange(time.Date(year, time.January, 1, 0, 0, 0, 0, loc), time.Date(year, time.December, 31, 0, 0, 0, 0, loc)).
+		CalculateSunPositions()
+}
+
+func fajrValidsOfYear(opt option.Option, year int) (sunPositions.SunPositions, []bool, error) {
+	yearOpt, err := yearOption(opt, year)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sunPoss := yearOpt.GetSunPositions()
+	valids := make([]bool, len(sunPoss))
+	for i, sunPosition := range sunPoss {
+		valids[i] = !isAngleUndefined(yearOpt.CalculateFajrHighAltitude(sunPosition.Declination))
+	}
+
+	return sunPoss, valids, nil
+}
+
+// FajrValidRange returns the first and the last date of the year on which the fajr zenith angle is reached.
+// The range wraps around the end of the year when the start date is after the end date.
+func (s *Schedule) FajrValidRange(opt option.Option, year int) (time.Time, time.Time, bool, error) {
+	if err := opt.ValidateBySalat(salatEnum.Fajr); err != nil {
+		return time.Time{}, time.Time{}, false, err
+	}
+
+	sunPoss, valids, calcErr := fajrValidsOfYear(opt, year)
+	if calcErr != nil {
+		return time.Time{}, time.Time{}, false, calcErr
+	}
+
+	validCount := 0
+	for _, valid := range valids {
+		if valid {
+			validCount++
+		}
+	}
+
+	if validCount == len(valids) {
+		return sunPoss[0].Date, sunPoss[len(sunPoss)-1].Date, true, nil
+	}
+
+	if validCount == 0 {
+		return time.Time{}, time.Time{}, false, err.ErrFajrNeverValid
+	}
+
+	n := len(valids)
+	startIdx := 0
+	for i := range valids {
+		if valids[i] && !valids[(i-1+n)%n] {
+			startIdx = i
+			break
+		}
+	}
+
+	endIdx := startIdx
+	for valids[(endIdx+1)%n] {
+		endIdx = (endIdx + 1) % n
+	}
+
+	return sunPoss[startIdx].Date, sunPoss[endIdx].Date, false, nil
+}
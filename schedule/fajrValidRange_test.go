@@ -0,0 +1,63 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFajrValidRange(t *testing.T) {
+	tests := []struct {
+		name        string
+		latitude    float64
+		alwaysValid bool
+		startMonth  time.Month
+		endMonth    time.Month
+	}{
+		{"60°N summer gap wraps the year", 60., false, time.August, time.April},
+		{"60°S winter gap", -60., false, time.February, time.October},
+		{"jakarta all year", -6.2, true, time.January, time.December},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opt := newTestOption(tt.latitude, 0., time.UTC, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))
+
+			start, end, alwaysValid, err := (&Schedule{}).FajrValidRange(opt, 2024)
+			if err != nil {
+				t.Fatalf("FajrValidRange() error = %v", err)
+			}
+
+			if alwaysValid != tt.alwaysValid {
+				t.Errorf("FajrValidRange() always valid = %v, want %v", alwaysValid, tt.alwaysValid)
+			}
+
+			if start.Month() != tt.startMonth || end.Month() != tt.endMonth {
+				t.Errorf("FajrValidRange() = %v - %v, want from %s to %s", start, end, tt.startMonth, tt.endMonth)
+			}
+
+			if tt.alwaysValid {
+				if start.Day() != 1 || end.Day() != 31 {
+					t.Errorf("FajrValidRange() = %v - %v, want the whole year", start, end)
+				}
+
+				return
+			}
+
+			_, valids, err := fajrValidsOfYear(opt, 2024)
+			if err != nil {
+				t.Fatalf("fajrValidsOfYear() error = %v", err)
+			}
+
+			startIdx, endIdx := start.YearDay()-1, end.YearDay()-1
+			if valids[(startIdx-1+len(valids))%len(valids)] || valids[(endIdx+1)%len(valids)] {
+				t.Errorf("FajrValidRange() = %v - %v, want bounded by the invalid dates", start, end)
+			}
+
+			for i := startIdx; i != (endIdx+1)%len(valids); i = (i + 1) % len(valids) {
+				if !valids[i] {
+					t.Errorf("day %d inside the range is not valid", i+1)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package schedule
+
+import (
+	"time"
+
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	"github.com/naufalfmm/moslem-salat-times/option"
+)
+
+// IshaAfterMaghrib returns the interval from the maghrib to the isha of each date of the year keyed by the local midnight of the date.
+// The dates on which either one is undefined, such as the summer nights of the higher latitudes, are left out.
+func (s *Schedule) IshaAfterMaghrib(opt option.Option, year int) (map[time.Time]time.Duration, error) {
+	if err := opt.ValidateBySalat(salatEnum.Isha); err != nil {
+		return nil, err
+	}
+
+	yearOpt, err := yearOption(opt, year)
+	if err != nil {
+		return nil, err
+	}
+
+	periodicAllSalatTimes, err := s.allTimes(yearOpt.SetSalats(salatEnum.Maghrib, salatEnum.Isha))
+	if err != nil {
+		return nil, err
+	}
+
+	intervals := make(map[time.Time]time.Duration, len(periodicAllSalatTimes))
+	for _, allSalatTime := range periodicAllSalatTimes {
+		maghrib, isha := allSalatTime.SalatTimes[0], allSalatTime.SalatTimes[1]
+		if maghrib.Unavailable || isha.Unavailable {
+			continue
+		}
+
+		year, month, day := allSalatTime.Date.Date()
+		intervals[time.Date(year, month, day, 0, 0, 0, 0, allSalatTime.Date.Location())] = isha.RawTime.Sub(maghrib.RawTime)
+	}
+
+	return intervals, nil
+}
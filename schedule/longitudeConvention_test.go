@@ -0,0 +1,89 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/naufalfmm/angle"
+	longitudeConventionEnum "github.com/naufalfmm/moslem-salat-times/enum/longitudeConvention"
+	"github.com/naufalfmm/moslem-salat-times/option"
+)
+
+func TestLongitudeConventionSetterOrder(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("LoadLocation() error = %v", err)
+	}
+
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, newYork)
+	eastPositive := newTestOption(40.7128, -74.006, newYork, date)
+
+	westPositiveCity, err := (&Option{}).SetCity("New York")
+	if err != nil {
+		t.Fatalf("SetCity() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		opt  option.Option
+	}{
+		{"convention before the longitude", newTestOption(0., 0., newYork, date).
+			SetLongitudeConvention(longitudeConventionEnum.WestPositive).
+			SetLatitudeLongitude(angle.NewDegreeFromFloat(40.7128), angle.NewDegreeFromFloat(74.006))},
+		{"convention after the longitude", newTestOption(40.7128, 74.006, newYork, date).
+			SetLongitudeConvention(longitudeConventionEnum.WestPositive)},
+		{"convention after the city", westPositiveCity.
+			SetDateRange(date, date).
+			SetLongitudeConvention(longitudeConventionEnum.WestPositive)},
+	}
+
+	want, err := (&Schedule{}).Dhuhr(eastPositive)
+	if err != nil {
+		t.Fatalf("Dhuhr() east positive error = %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&Schedule{}).Dhuhr(tt.opt)
+			if err != nil {
+				t.Fatalf("Dhuhr() error = %v", err)
+			}
+
+			if diff := got[0].RawTime.Sub(want[0].RawTime); diff < -time.Minute || diff > time.Minute {
+				t.Errorf("Dhuhr() = %v, want %v", got[0].RawTime, want[0].RawTime)
+			}
+		})
+	}
+}
+
+func TestWithLongitudeConventionOrder(t *testing.T) {
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	convention := WithLongitudeConvention(longitudeConventionEnum.WestPositive)
+	coordinate := WithLatitudeLongitude(angle.NewDegreeFromFloat(40.7128), angle.NewDegreeFromFloat(74.006))
+	dateRange := withDateRange{dateStart: date, dateEnd: date}
+
+	before, after := CommOpt{}, CommOpt{}
+	for _, applyOpt := range []ApplyCommOpt{convention, coordinate, dateRange} {
+		applyOpt.Apply(&before)
+	}
+
+	for _, applyOpt := range []ApplyCommOpt{coordinate, convention, dateRange} {
+		applyOpt.Apply(&after)
+	}
+
+	beforeOpt, err := before.CalculateSunPositions()
+	if err != nil {
+		t.Fatalf("CalculateSunPositions() error = %v", err)
+	}
+
+	afterOpt, err := after.CalculateSunPositions()
+	if err != nil {
+		t.Fatalf("CalculateSunPositions() error = %v", err)
+	}
+
+	beforeTransit, afterTransit := beforeOpt.sunPositions[0].SunTransitTime.ToDegree().ToFloat(), afterOpt.sunPositions[0].SunTransitTime.ToDegree().ToFloat()
+	if beforeTransit != afterTransit || beforeTransit < 16. {
+		t.Errorf("transit = %v h before and %v h after, want the same west of greenwich", beforeTransit, afterTransit)
+	}
+}
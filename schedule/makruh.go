@@ -0,0 +1,86 @@
+package schedule
+
+import (
+	"time"
+
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	"github.com/naufalfmm/moslem-salat-times/option"
+	"github.com/naufalfmm/moslem-salat-times/utils/sunPositions"
+)
+
+const (
+	makruhSunrise = "sunrise"
+	makruhZawal   = "zawal"
+	makruhSunset  = "sunset"
+)
+
+type makruhWindow struct {
+	name  string
+	start time.Time
+	end   time.Time
+}
+
+// zawalWindow returns the window of the zawal width centered on the solar transit
+func zawalWindow(opt option.Option, sunPos sunPositions.SunPosition) (time.Time, time.Time) {
+	transit := angleTimeOnDate(sunPos.SunTransitTime, sunPos.Date)
+	halfWidth := opt.GetZawalWidth() / 2
+
+	return transit.Add(-halfWidth), transit.Add(halfWidth)
+}
+
+// makruhWindows returns the disliked windows of the day, that are after the sunrise, around the solar transit, and before the sunset.
+// The sunrise and sunset windows are left out when the sun does not rise or set.
+func makruhWindows(opt option.Option, sunPos sunPositions.SunPosition) []makruhWindow {
+	afterSunrise, beforeSunset := opt.GetMakruhWidths()
+	zawalStart, zawalEnd := zawalWindow(opt, sunPos)
+	windows := []makruhWindow{{name: makruhZawal, start: zawalStart, end: zawalEnd}}
+
+	if sunrise := sunriseAngleTime(opt, sunPos); !isAngleUndefined(sunrise) {
+		start := angleTimeOnDate(sunrise, sunPos.Date)
+		windows = append(windows, makruhWindow{name: makruhSunrise, start: start, end: start.Add(afterSunrise)})
+	}
+
+	if sunset := sunsetAngleTime(opt, sunPos); !isAngleUndefined(sunset) {
+		end := angleTimeOnDate(sunset, sunPos.Date)
+		windows = append(windows, makruhWindow{name: makruhSunset, start: end.Add(-beforeSunset), end: end})
+	}
+
+	return windows
+}
+
+func (s *Schedule) ZawalWindow(opt option.Option, date time.Time) (time.Time, time.Time, error) {
+	if err := opt.ValidateBySalat(salatEnum.Dhuhr); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	dateOpt, err := opt.Clone().SetDateRange(date, date).CalculateSunPositions()
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	start, end := zawalWindow(dateOpt, dateOpt.GetSunPositions()[0])
+
+	return start, end, nil
+}
+
+// IsMakruhTime reports whether t falls in one of the disliked windows and returns the window name, that is sunrise, zawal, or sunset
+func (s *Schedule) IsMakruhTime(opt option.Option, t time.Time) (bool, string, error) {
+	if err := opt.ValidateBySalat(salatEnum.Sunrise); err != nil {
+		return false, "", err
+	}
+
+	date := t.In(opt.GetTimezone())
+
+	dateOpt, err := opt.Clone().SetDateRange(date, date).CalculateSunPositions()
+	if err != nil {
+		return false, "", err
+	}
+
+	for _, window := range makruhWindows(dateOpt, dateOpt.GetSunPositions()[0]) {
+		if !t.Before(window.start) && !t.After(window.end) {
+			return true, window.name, nil
+		}
+	}
+
+	return false, "", nil
+}
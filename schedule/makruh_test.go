@@ -0,0 +1,128 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsMakruhTime(t *testing.T) {
+	jakarta := time.FixedZone("0700", 7*60*60)
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, jakarta)
+	opt := newTestOption(-6.2088, 106.8456, jakarta, date).
+		SetMakruhWidths(15*time.Minute, 15*time.Minute).
+		SetZawalWidth(10 * time.Minute)
+
+	dateOpt, calcErr := opt.Clone().CalculateSunPositions()
+	if calcErr != nil {
+		t.Fatalf("CalculateSunPositions() error = %v", calcErr)
+	}
+
+	windows := makruhWindows(dateOpt, dateOpt.GetSunPositions()[0])
+	if len(windows) != 3 {
+		t.Fatalf("makruhWindows() = %d windows, want 3", len(windows))
+	}
+
+	for _, window := range windows {
+		tests := []struct {
+			name     string
+			at       time.Time
+			want     bool
+			wantName string
+		}{
+			{"start", window.start, true, window.name},
+			{"middle", window.start.Add(window.end.Sub(window.start) / 2), true, window.name},
+			{"end", window.end, true, window.name},
+			{"before", window.start.Add(-time.Minute), false, ""},
+			{"after", window.end.Add(time.Minute), false, ""},
+		}
+
+		for _, tt := range tests {
+			t.Run(window.name+" "+tt.name, func(t *testing.T) {
+				got, gotName, calcErr := (&Schedule{}).IsMakruhTime(opt, tt.at)
+				if calcErr != nil {
+					t.Fatalf("IsMakruhTime() error = %v", calcErr)
+				}
+
+				if got != tt.want || gotName != tt.wantName {
+					t.Errorf("IsMakruhTime(%v) = %v, %q, want %v, %q", tt.at, got, gotName, tt.want, tt.wantName)
+				}
+			})
+		}
+	}
+}
+
+func TestIsMakruhTimePolarNight(t *testing.T) {
+	longyearbyen := time.FixedZone("0100", 60*60)
+	date := time.Date(2024, time.December, 21, 0, 0, 0, 0, longyearbyen)
+	opt := newTestOption(78.2232, 15.6267, longyearbyen, date)
+
+	dateOpt, calcErr := opt.Clone().CalculateSunPositions()
+	if calcErr != nil {
+		t.Fatalf("CalculateSunPositions() error = %v", calcErr)
+	}
+
+	windows := makruhWindows(dateOpt, dateOpt.GetSunPositions()[0])
+	if len(windows) != 1 || windows[0].name != makruhZawal {
+		t.Fatalf("makruhWindows() = %+v, want only the zawal window", windows)
+	}
+
+	got, gotName, calcErr := (&Schedule{}).IsMakruhTime(opt, windows[0].start.Add(-2*time.Hour))
+	if calcErr != nil {
+		t.Fatalf("IsMakruhTime() error = %v", calcErr)
+	}
+
+	if got || gotName != "" {
+		t.Errorf("IsMakruhTime() in the polar morning = %v, %q, want false", got, gotName)
+	}
+
+	got, gotName, calcErr = (&Schedule{}).IsMakruhTime(opt, windows[0].start)
+	if calcErr != nil {
+		t.Fatalf("IsMakruhTime() error = %v", calcErr)
+	}
+
+	if !got || gotName != makruhZawal {
+		t.Errorf("IsMakruhTime() at the zawal = %v, %q, want true, %q", got, gotName, makruhZawal)
+	}
+}
+
+func TestZawalWindow(t *testing.T) {
+	jakarta := time.FixedZone("0700", 7*60*60)
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, jakarta)
+
+	tests := []struct {
+		name      string
+		width     time.Duration
+		wantWidth time.Duration
+	}{
+		{"default width", 0, 2 * time.Minute},
+		{"10 minutes", 10 * time.Minute, 10 * time.Minute},
+		{"30 minutes", 30 * time.Minute, 30 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opt := newTestOption(-6.2088, 106.8456, jakarta, date).SetZawalWidth(tt.width)
+
+			start, end, calcErr := (&Schedule{}).ZawalWindow(opt, date)
+			if calcErr != nil {
+				t.Fatalf("ZawalWindow() error = %v", calcErr)
+			}
+
+			dateOpt, calcErr := opt.Clone().CalculateSunPositions()
+			if calcErr != nil {
+				t.Fatalf("CalculateSunPositions() error = %v", calcErr)
+			}
+
+			sunPos := dateOpt.GetSunPositions()[0]
+			transit := angleTimeOnDate(sunPos.SunTransitTime, sunPos.Date)
+
+			if got := end.Sub(start); got != tt.wantWidth {
+				t.Errorf("ZawalWindow() width = %v, want %v", got, tt.wantWidth)
+			}
+
+			if got := start.Add(end.Sub(start) / 2); !got.Equal(transit) {
+				t.Errorf("ZawalWindow() center = %v, want the transit %v", got, transit)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package schedule
+
+import (
+	"time"
+
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	"github.com/naufalfmm/moslem-salat-times/option"
+)
+
+// MonthlyAverages returns the mean unrounded time of each salat per month of the year as the duration since the local midnight of the date.
+// The midnight before the date has the negative duration. The days on which the salat is undefined, such as the polar days, are left out.
+func (s *Schedule) MonthlyAverages(opt option.Option, year int) (map[time.Month]map[salatEnum.Salat]time.Duration, error) {
+	if err := opt.ValidateBySalat(0); err != nil {
+		return nil, err
+	}
+
+	yearOpt, err := yearOption(opt, year)
+	if err != nil {
+		return nil, err
+	}
+
+	periodicAllSalatTimes, err := s.allTimes(yearOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	sums := map[time.Month]map[salatEnum.Salat]time.Duration{}
+	counts := map[time.Month]map[salatEnum.Salat]int{}
+	for i, sunPosition := range yearOpt.GetSunPositions() {
+		date := sunPosition.Date
+		month := date.Month()
+
+		if sums[month] == nil {
+			sums[month] = map[salatEnum.Salat]time.Duration{}
+			counts[month] = map[salatEnum.Salat]int{}
+		}
+
+		midnight := time.Date(date.Year(), month, date.Day(), 0, 0, 0, 0, date.Location())
+		for _, salatTime := range periodicAllSalatTimes[i].SalatTimes {
+			if isSalatUndefined(yearOpt, salatTime.Salat, sunPosition) {
+				continue
+			}
+
+			sums[month][salatTime.Salat] += salatTime.RawTime.Sub(midnight)
+			counts[month][salatTime.Salat]++
+		}
+	}
+
+	averages := make(map[time.Month]map[salatEnum.Salat]time.Duration, len(sums))
+	for month, salatSums := range sums {
+		averages[month] = map[salatEnum.Salat]time.Duration{}
+
+		for salat, sum := range salatSums {
+			averages[month][salat] = sum / time.Duration(counts[month][salat])
+		}
+	}
+
+	return averages, nil
+}
@@ -0,0 +1,60 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+)
+
+func TestMonthlyAverages(t *testing.T) {
+	date := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	averages, calcErr := (&Schedule{}).MonthlyAverages(newTestOption(45., 0., time.UTC, date), 2024)
+	if calcErr != nil {
+		t.Fatalf("MonthlyAverages() error = %v", calcErr)
+	}
+
+	if len(averages) != 12 {
+		t.Fatalf("MonthlyAverages() = %d months, want 12", len(averages))
+	}
+
+	for month := time.January; month <= time.December; month++ {
+		salatAverages, ok := averages[month]
+		if !ok {
+			t.Errorf("MonthlyAverages() misses %s", month)
+			continue
+		}
+
+		if dhuhr := salatAverages[salatEnum.Dhuhr]; dhuhr < 11*time.Hour+40*time.Minute || dhuhr > 12*time.Hour+20*time.Minute {
+			t.Errorf("MonthlyAverages() dhuhr of %s = %v, want within the equation of time of the local noon", month, dhuhr)
+		}
+
+		if sunrise, sunset := salatAverages[salatEnum.Sunrise], salatAverages[salatEnum.Sunset]; sunrise >= sunset {
+			t.Errorf("MonthlyAverages() sunrise of %s = %v, want before the sunset %v", month, sunrise, sunset)
+		}
+	}
+}
+
+func TestMonthlyAveragesPolar(t *testing.T) {
+	date := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	averages, calcErr := (&Schedule{}).MonthlyAverages(newTestOption(78.2232, 0., time.UTC, date), 2024)
+	if calcErr != nil {
+		t.Fatalf("MonthlyAverages() error = %v", calcErr)
+	}
+
+	if len(averages) != 12 {
+		t.Fatalf("MonthlyAverages() = %d months, want 12", len(averages))
+	}
+
+	for _, month := range []time.Month{time.December, time.June} {
+		if sunrise, ok := averages[month][salatEnum.Sunrise]; ok {
+			t.Errorf("MonthlyAverages() sunrise of %s = %v, want left out of the polar month", month, sunrise)
+		}
+
+		if _, ok := averages[month][salatEnum.Dhuhr]; !ok {
+			t.Errorf("MonthlyAverages() misses the dhuhr of %s", month)
+		}
+	}
+}
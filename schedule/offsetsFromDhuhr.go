@@ -0,0 +1,36 @@
+package schedule
+
+import (
+	"time"
+
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	"github.com/naufalfmm/moslem-salat-times/option"
+)
+
+// OffsetsFromDhuhr returns the signed offset of each selected salat time of the date from the dhuhr, negative before the dhuhr.
+// The unavailable salat is left out.
+func (s *Schedule) OffsetsFromDhuhr(opt option.Option, date time.Time) (map[salatEnum.Salat]time.Duration, error) {
+	allSalatTimes, err := s.allTimes(opt.Clone().SetDateRange(date, date))
+	if err != nil {
+		return nil, err
+	}
+
+	dateOpt, err := opt.Clone().SetDateRange(date, date).CalculateSunPositions()
+	if err != nil {
+		return nil, err
+	}
+
+	sunPosition := dateOpt.GetSunPositions()[0]
+	dhuhr := newSalatTime(dateOpt, sunPosition.Date, salatEnum.Dhuhr, dhuhrAngleTime(dateOpt, sunPosition))
+
+	offsets := make(map[salatEnum.Salat]time.Duration, len(allSalatTimes[0].SalatTimes))
+	for _, salatTime := range allSalatTimes[0].SalatTimes {
+		if salatTime.Unavailable {
+			continue
+		}
+
+		offsets[salatTime.Salat] = salatTime.Time.Sub(dhuhr.Time)
+	}
+
+	return offsets, nil
+}
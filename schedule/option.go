@@ -2,43 +2,105 @@ package schedule
 
 import (
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/naufalfmm/angle"
 	"github.com/naufalfmm/angle/trig"
 	"github.com/naufalfmm/moslem-salat-times/consts"
+	accuracyModeEnum "github.com/naufalfmm/moslem-salat-times/enum/accuracyMode"
+	dhuhrDefinitionEnum "github.com/naufalfmm/moslem-salat-times/enum/dhuhrDefinition"
 	higherLatEnum "github.com/naufalfmm/moslem-salat-times/enum/higherLat"
+	longitudeConventionEnum "github.com/naufalfmm/moslem-salat-times/enum/longitudeConvention"
 	mazhabEnum "github.com/naufalfmm/moslem-salat-times/enum/mazhab"
 	periodicalEnum "github.com/naufalfmm/moslem-salat-times/enum/periodical"
 	roundingTimeOptionEnum "github.com/naufalfmm/moslem-salat-times/enum/roundingTimeOption"
 	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	shafaqEnum "github.com/naufalfmm/moslem-salat-times/enum/shafaq"
+	sunDiscEnum "github.com/naufalfmm/moslem-salat-times/enum/sunDisc"
 	sunZenithEnum "github.com/naufalfmm/moslem-salat-times/enum/sunZenith"
+	twilightEnum "github.com/naufalfmm/moslem-salat-times/enum/twilight"
 	"github.com/naufalfmm/moslem-salat-times/err"
+	"github.com/naufalfmm/moslem-salat-times/model"
 	"github.com/naufalfmm/moslem-salat-times/option"
+	"github.com/naufalfmm/moslem-salat-times/utils/coordinate"
+	"github.com/naufalfmm/moslem-salat-times/utils/gazetteer"
+	"github.com/naufalfmm/moslem-salat-times/utils/qibla"
 	"github.com/naufalfmm/moslem-salat-times/utils/salatHighAltitude"
+	"github.com/naufalfmm/moslem-salat-times/utils/shafaq"
 	"github.com/naufalfmm/moslem-salat-times/utils/sunPositions"
 )
 
+var allTimesSalats = []salatEnum.Salat{
+	salatEnum.Midnight,
+	salatEnum.Fajr,
+	salatEnum.Sunrise,
+	salatEnum.Dhuhr,
+	salatEnum.Asr,
+	salatEnum.Sunset,
+	salatEnum.Maghrib,
+	salatEnum.Isha,
+}
+
 type Option struct {
 	dateStart  time.Time
 	dateEnd    time.Time
 	periodical periodicalEnum.Periodical
+	weekStart  *time.Weekday
+	clock      func() time.Time
 
 	latitude    angle.Angle
 	longitude   angle.Angle
 	elevation   float64
 	timezoneLoc *time.Location
 
+	// latitudeSet and longitudeSet tell the explicit zero of the equator and the prime meridian apart from the unset coordinate
+	latitudeSet         bool
+	longitudeSet        bool
+	longitudeConvention longitudeConventionEnum.LongitudeConvention
+	// cityLongitude marks the longitude of the gazetteer city, which is east positive regardless of the convention
+	cityLongitude bool
+
+	qiblaReference *model.Coordinate
+
 	fajrZenith     angle.Angle
 	ishaZenith     angle.Angle
 	ishaZenithType sunZenithEnum.IshaZenithType
+	maghribZenith  angle.Angle
+
+	ishaIgnoresElevation  bool
+	shafaqType            shafaqEnum.Shafaq
+	useGeocentricLatitude bool
+
+	sunriseSunsetZenith *angle.Angle
+	sunDiscReference    sunDiscEnum.SunDisc
+	dhuhrDefinition     dhuhrDefinitionEnum.DhuhrDefinition
 
 	mazhab               mazhabEnum.Mazhab
 	higherLatitudeMethod higherLatEnum.HigherLat
+	nearestLatitude      *angle.Angle
 
 	roundingTimeOption roundingTimeOptionEnum.RoundingTimeOption
+	globalOffset       time.Duration
+	accuracyMode       accuracyModeEnum.AccuracyMode
+	solarOverride      *sunPositions.SolarOverride
+	ephemeris          sunPositions.Ephemeris
+
+	makruhAfterSunrise time.Duration
+	makruhBeforeSunset time.Duration
+	zawalWidth         time.Duration
+	minNightFraction   float64
+	tahajjudUntilFajr  bool
+
+	excludedDates map[time.Time]bool
+	specialDates  map[time.Time]model.SpecialHandling
+
+	salats []salatEnum.Salat
 
 	sunPositions sunPositions.SunPositions
+
+	// applyErr keeps the error of the applied option, e.g. the unknown city, until the option is validated
+	applyErr error
 }
 
 func (o *Option) SetDateRange(dateStart, dateEnd time.Time) option.Option {
@@ -51,12 +113,71 @@ func (o *Option) SetDateRange(dateStart, dateEnd time.Time) option.Option {
 	return o
 }
 
+// calendarDate keys the date by its calendar date regardless of its clock and location
+func calendarDate(date time.Time) time.Time {
+	year, month, day := date.Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func calendarDateSet(dates []time.Time) map[time.Time]bool {
+	dateSet := make(map[time.Time]bool, len(dates))
+	for _, date := range dates {
+		dateSet[calendarDate(date)] = true
+	}
+
+	return dateSet
+}
+
+func calendarDateSpecials(specialDates map[time.Time]model.SpecialHandling) map[time.Time]model.SpecialHandling {
+	specials := make(map[time.Time]model.SpecialHandling, len(specialDates))
+	for date, special := range specialDates {
+		specials[calendarDate(date)] = special
+	}
+
+	return specials
+}
+
+// SetExcludedDates leaves the dates out of the salat times of the date range, matched by their calendar date
+func (o *Option) SetExcludedDates(dates []time.Time) option.Option {
+	o.excludedDates = calendarDateSet(dates)
+
+	return o
+}
+
+// SetSpecialDates applies the special handling to the salat times of the dates, matched by their calendar date
+func (o *Option) SetSpecialDates(specialDates map[time.Time]model.SpecialHandling) option.Option {
+	o.specialDates = calendarDateSpecials(specialDates)
+
+	return o
+}
+
+// SetDateRangeExclusive sets the half-open date range which leaves out the end date, so the consecutive ranges can be chained
+func (o *Option) SetDateRangeExclusive(dateStart, dateEnd time.Time) option.Option {
+	return o.SetDateRange(dateStart, dateEnd.AddDate(0, 0, -1))
+}
+
 func (o *Option) SetNow() option.Option {
-	return o.SetDateRange(time.Now(), time.Now())
+	now := o.Now()
+	return o.SetDateRange(now, now)
+}
+
+func (o *Option) SetClock(clock func() time.Time) option.Option {
+	o.clock = clock
+
+	return o
+}
+
+func (o *Option) periodicalDateRange(date time.Time, periodical periodicalEnum.Periodical) (time.Time, time.Time) {
+	if o.weekStart == nil {
+		return periodical.GetDateRange(date)
+	}
+
+	return periodical.GetDateRangeByWeekStart(date, *o.weekStart)
 }
 
 func (o *Option) SetDatePeriodical(dateStart time.Time, periodical periodicalEnum.Periodical) option.Option {
-	o.dateStart, o.dateEnd = periodical.GetDateRange(dateStart)
+	o.dateStart, o.dateEnd = o.periodicalDateRange(dateStart, periodical)
 	o.periodical = periodical
 
 	o.sunPositions = nil
@@ -66,15 +187,87 @@ func (o *Option) SetDatePeriodical(dateStart time.Time, periodical periodicalEnu
 
 func (o *Option) SetPeriodical(periodical periodicalEnum.Periodical) option.Option {
 	if o.dateStart.IsZero() {
-		o.dateStart = time.Now()
+		o.dateStart = o.Now()
 	}
 
 	return o.SetDatePeriodical(o.dateStart, periodical)
 }
 
+func (o *Option) SetWeekStart(weekStart time.Weekday) option.Option {
+	o.weekStart = &weekStart
+
+	if o.periodical == periodicalEnum.Weekly {
+		return o.SetDatePeriodical(o.dateStart, o.periodical)
+	}
+
+	return o
+}
+
+// eastLongitude returns the longitude kept as given normalized into the east positive longitude by the convention
+func (o *Option) eastLongitude() angle.Angle {
+	if o.cityLongitude {
+		return o.longitude
+	}
+
+	return toEastPositive(o.longitude, o.longitudeConvention)
+}
+
+// toEastPositive normalizes the longitude given in the convention into the standard east positive longitude
+func toEastPositive(longitude angle.Angle, convention longitudeConventionEnum.LongitudeConvention) angle.Angle {
+	if convention.EastSign() > 0 || longitude == (angle.Angle{}) {
+		return longitude
+	}
+
+	return angle.NewDegreeFromFloat(-longitude.ToDegree().ToFloat())
+}
+
+// SetLongitudeConvention sets the sign convention of the longitude, given before or after it, which is normalized on the calculation.
+// The longitude of the gazetteer city is always east positive.
+func (o *Option) SetLongitudeConvention(convention longitudeConventionEnum.LongitudeConvention) option.Option {
+	o.longitudeConvention = convention
+
+	o.sunPositions = nil
+
+	return o
+}
+
 func (o *Option) SetLatitudeLongitude(latitude, longitude angle.Angle) option.Option {
 	o.latitude = latitude
 	o.longitude = longitude
+	o.cityLongitude = false
+	o.latitudeSet = true
+	o.longitudeSet = true
+
+	return o
+}
+
+// SetCity sets the latitude, longitude, and timezone from the bundled gazetteer of major cities
+func (o *Option) SetCity(name string) (option.Option, error) {
+	city, err := gazetteer.Lookup(name)
+	if err != nil {
+		return o, err
+	}
+
+	timezoneLoc, err := time.LoadLocation(city.Timezone)
+	if err != nil {
+		return o, err
+	}
+
+	o.latitude = city.Latitude
+	o.longitude = city.Longitude
+	o.cityLongitude = true
+	o.latitudeSet = true
+	o.longitudeSet = true
+	o.timezoneLoc = timezoneLoc
+
+	return o, nil
+}
+
+func (o *Option) SetQiblaReference(latitude, longitude angle.Angle) option.Option {
+	o.qiblaReference = &model.Coordinate{
+		Latitude:  latitude,
+		Longitude: longitude,
+	}
 
 	return o
 }
@@ -97,12 +290,77 @@ func (o *Option) SetHigherLatitudeMethod(higherLatMethod higherLatEnum.HigherLat
 	return o
 }
 
+// SetNearestLatitude chooses the nearest latitude method with the reference latitude.
+// The fajr and isha zenith not reached beyond the reference latitude are computed at the reference latitude on the actual transit.
+func (o *Option) SetNearestLatitude(referenceLatitude angle.Angle) option.Option {
+	o.higherLatitudeMethod = higherLatEnum.NearestLatitude
+	o.nearestLatitude = &referenceLatitude
+
+	return o
+}
+
 func (o *Option) SetRoundingTimeOption(roundingTimeOpt roundingTimeOptionEnum.RoundingTimeOption) option.Option {
 	o.roundingTimeOption = roundingTimeOpt
 
 	return o
 }
 
+// SetGlobalOffset shifts every computed salat time by the constant offset before the rounding
+func (o *Option) SetGlobalOffset(offset time.Duration) option.Option {
+	o.globalOffset = offset
+
+	return o
+}
+
+func (o *Option) SetAccuracyMode(accuracyMode accuracyModeEnum.AccuracyMode) option.Option {
+	o.accuracyMode = accuracyMode
+
+	o.sunPositions = nil
+
+	return o
+}
+
+// SetSolarOverride fixes the declination and the equation of time of every date instead of computing the sun positions
+func (o *Option) SetSolarOverride(declination angle.Angle, equationOfTime time.Duration) option.Option {
+	o.solarOverride = &sunPositions.SolarOverride{
+		Declination:    declination,
+		EquationOfTime: equationOfTime,
+	}
+	o.ephemeris = nil
+
+	o.sunPositions = nil
+
+	return o
+}
+
+// ClearSolarOverride computes the sun positions again instead of taking the overridden declination and equation of time
+func (o *Option) ClearSolarOverride() option.Option {
+	o.solarOverride = nil
+
+	o.sunPositions = nil
+
+	return o
+}
+
+// SetEphemeris takes the declination and the equation of time of every date from the ephemeris instead of computing the sun positions
+func (o *Option) SetEphemeris(ephemeris func(date time.Time) (angle.Angle, time.Duration, error)) option.Option {
+	o.ephemeris = ephemeris
+	o.solarOverride = nil
+
+	o.sunPositions = nil
+
+	return o
+}
+
+// ClearEphemeris computes the sun positions again instead of taking the declination and equation of time from the ephemeris
+func (o *Option) ClearEphemeris() option.Option {
+	o.ephemeris = nil
+
+	o.sunPositions = nil
+
+	return o
+}
+
 func (o *Option) SetTimezoneOffset(timezoneOffset float64) option.Option {
 	angTime := angle.NewDegreeFromFloat(timezoneOffset)
 
@@ -116,6 +374,30 @@ func (o *Option) SetTimezoneOffset(timezoneOffset float64) option.Option {
 	return o
 }
 
+// timezoneOffsetSecondsZone names the fixed zone like SetTimezoneOffset does, adding the seconds only when there are any, e.g. 052110
+func timezoneOffsetSecondsZone(timezoneOffsetSeconds int) *time.Location {
+	negStr := ""
+	offset := timezoneOffsetSeconds
+	if offset < 0 {
+		negStr = "-"
+		offset = -offset
+	}
+
+	name := fmt.Sprintf("%s%02d%02d", negStr, offset/3600, offset%3600/60)
+	if second := offset % 60; second != 0 {
+		name = fmt.Sprintf("%s%02d", name, second)
+	}
+
+	return time.FixedZone(name, timezoneOffsetSeconds)
+}
+
+// SetTimezoneOffsetSeconds sets the fixed timezone offset in seconds, for the historical local mean time such as +5:21:10
+func (o *Option) SetTimezoneOffsetSeconds(timezoneOffsetSeconds int) option.Option {
+	o.timezoneLoc = timezoneOffsetSecondsZone(timezoneOffsetSeconds)
+
+	return o
+}
+
 func (o *Option) SetTimezone(timezone *time.Location) option.Option {
 	o.timezoneLoc = timezone
 
@@ -130,24 +412,133 @@ func (o *Option) SetFajrIshaZenith(fajrZenith, ishaZenith angle.Angle) option.Op
 	return o
 }
 
+// SetSymmetricTwilight is the convenience of SetFajrIshaZenith which mirrors the same zenith for the fajr and isha
+func (o *Option) SetSymmetricTwilight(zenith angle.Angle) option.Option {
+	return o.SetFajrIshaZenith(zenith, zenith)
+}
+
+// SetTwilightConvention sets the fajr and isha zenith by the depression of the civil, nautical, or astronomical twilight
+func (o *Option) SetTwilightConvention(fajr, isha twilightEnum.Twilight) option.Option {
+	return o.SetFajrIshaZenith(angle.NewDegreeFromFloat(fajr.Depression()), angle.NewDegreeFromFloat(isha.Depression()))
+}
+
 func (o *Option) SetSunZenith(sunZenith sunZenithEnum.SunZenith) option.Option {
 	o.fajrZenith = sunZenith.FajrZenith()
 	o.ishaZenith = sunZenith.IshaZenith().Angle
 	o.ishaZenithType = sunZenith.IshaZenith().Type
+	o.maghribZenith = sunZenith.MaghribZenith()
+
+	return o
+}
+
+func (o *Option) SetMaghribZenith(maghribZenith angle.Angle) option.Option {
+	o.maghribZenith = maghribZenith
+
+	return o
+}
+
+// SetIshaIgnoresElevation applies the isha zenith at the geometric horizon, so the elevation only shifts the sunset
+func (o *Option) SetIshaIgnoresElevation(ignore bool) option.Option {
+	o.ishaIgnoresElevation = ignore
+
+	return o
+}
+
+// SetShafaqType computes the isha after the sunset by the seasonal shafaq of the Moonsighting Committee instead of the isha zenith.
+// The ahmar follows the red twilight, the abyad the later white one, and the general lies between them.
+func (o *Option) SetShafaqType(shafaqType shafaqEnum.Shafaq) option.Option {
+	o.shafaqType = shafaqType
+
+	return o
+}
+
+// SetUseGeocentricLatitude converts the geodetic latitude into the geocentric latitude before the solar calculation
+func (o *Option) SetUseGeocentricLatitude(useGeocentricLatitude bool) option.Option {
+	o.useGeocentricLatitude = useGeocentricLatitude
+
+	return o
+}
+
+func (o *Option) SetSunriseSunsetZenith(sunriseSunsetZenith angle.Angle) option.Option {
+	o.sunriseSunsetZenith = &sunriseSunsetZenith
+
+	return o
+}
+
+// SetSunDiscReference sets the part of the sun disc touching the horizon at the sunrise and sunset, the upper limb by default.
+// The center and the lower limb lift the sunrise and sunset zenith by one and two solar semidiameters.
+func (o *Option) SetSunDiscReference(sunDiscReference sunDiscEnum.SunDisc) option.Option {
+	o.sunDiscReference = sunDiscReference
+
+	return o
+}
+
+// SetDhuhrDefinition sets how far after the transit the dhuhr is, a slight margin by default.
+// The transit is the exact meridian passage and the decline plus margin waits for the whole sun disc to pass the meridian before the slight margin.
+func (o *Option) SetDhuhrDefinition(dhuhrDefinition dhuhrDefinitionEnum.DhuhrDefinition) option.Option {
+	o.dhuhrDefinition = dhuhrDefinition
+
+	return o
+}
+
+func (o *Option) SetSalats(salats ...salatEnum.Salat) option.Option {
+	o.salats = salats
+
+	return o
+}
+
+func (o *Option) SetMakruhWidths(afterSunrise, beforeSunset time.Duration) option.Option {
+	o.makruhAfterSunrise = afterSunrise
+	o.makruhBeforeSunset = beforeSunset
+
+	return o
+}
+
+func (o *Option) SetZawalWidth(width time.Duration) option.Option {
+	o.zawalWidth = width
+
+	return o
+}
+
+// isValidMinNightFraction reports whether the minimum night fraction is within [0, 1), the zero turning it off
+func isValidMinNightFraction(fraction float64) bool {
+	return fraction >= 0 && fraction < 1
+}
+
+// SetMinNightFraction keeps the night between the isha and the next fajr at least the fraction of the day, moving both toward the middle of the night.
+// The fraction out of [0, 1) is kept as the error reported by the validation.
+func (o *Option) SetMinNightFraction(fraction float64) option.Option {
+	if !isValidMinNightFraction(fraction) {
+		o.applyErr = err.ErrInvalidFraction
+		return o
+	}
+
+	o.minNightFraction = fraction
+
+	return o
+}
+
+// SetTahajjudUntilFajr measures the night of the tahajjud from the maghrib to the next fajr instead of from the sunset to the next sunrise
+func (o *Option) SetTahajjudUntilFajr(untilFajr bool) option.Option {
+	o.tahajjudUntilFajr = untilFajr
 
 	return o
 }
 
 func (o *Option) ValidateBySalat(salat salatEnum.Salat) error {
+	if o.applyErr != nil {
+		return o.applyErr
+	}
+
 	if o.dateStart.IsZero() {
 		return err.ErrDateMissing
 	}
 
-	if o.latitude.IsZero() {
+	if !o.latitudeSet {
 		return err.ErrLatitudeMissing
 	}
 
-	if o.longitude.IsZero() {
+	if !o.longitudeSet {
 		return err.ErrLongitudeMissing
 	}
 
@@ -163,7 +554,7 @@ func (o *Option) ValidateBySalat(salat salatEnum.Salat) error {
 		return err.ErrFajrZenithMissing
 	}
 
-	if o.ishaZenith.IsZero() && salat == salatEnum.Isha {
+	if o.ishaZenith.IsZero() && o.shafaqType == 0 && salat == salatEnum.Isha {
 		return err.ErrIshaZenithMissing
 	}
 
@@ -179,34 +570,154 @@ func (o *Option) CalculateSunPositions() (option.Option, error) {
 		return o, nil
 	}
 
-	o.sunPositions = sunPositions.NewFromDateRange(o.dateStart, o.dateEnd, o.timezoneLoc, o.longitude)
+	if o.solarOverride != nil {
+		o.sunPositions = sunPositions.NewFromOverride(o.dateStart, o.dateEnd, o.timezoneLoc, o.eastLongitude(), *o.solarOverride)
+		return o, nil
+	}
+
+	if o.ephemeris != nil {
+		sunPoss, err := sunPositions.NewFromEphemeris(o.dateStart, o.dateEnd, o.timezoneLoc, o.eastLongitude(), o.ephemeris)
+		if err != nil {
+			return nil, err
+		}
+
+		o.sunPositions = sunPoss
+		return o, nil
+	}
+
+	o.sunPositions = sunPositions.NewFromDateRange(o.dateStart, o.dateEnd, o.timezoneLoc, o.eastLongitude(), o.accuracyMode)
 	return o, nil
 }
 
+// solarLatitude returns the latitude used by the solar calculation, the geocentric one when it is chosen
+func (o *Option) solarLatitude() angle.Angle {
+	if o.useGeocentricLatitude {
+		return coordinate.GeocentricLatitude(o.latitude)
+	}
+
+	return o.latitude
+}
+
+// calcTwilightHighAltitude substitutes the nearest latitude for the latitude beyond it when the zenith is not reached
+func (o *Option) calcTwilightHighAltitude(zenith, declination angle.Angle, elevation float64) angle.Angle {
+	latitude := o.solarLatitude()
+
+	highAlt := salatHighAltitude.CalcSalatHighAltitude(zenith, latitude, declination, elevation)
+	if o.higherLatitudeMethod != higherLatEnum.NearestLatitude || !isAngleUndefined(highAlt) {
+		return highAlt
+	}
+
+	lat, reference := latitude.ToDegree().ToFloat(), o.GetNearestLatitude().ToDegree().ToFloat()
+	if math.Abs(lat) <= math.Abs(reference) {
+		return highAlt
+	}
+
+	return salatHighAltitude.CalcSalatHighAltitude(zenith, angle.NewDegreeFromFloat(math.Copysign(reference, lat)), declination, elevation)
+}
+
 func (o *Option) CalculateFajrHighAltitude(declination angle.Angle) angle.Angle {
-	return salatHighAltitude.CalcSalatHighAltitude(o.fajrZenith, o.latitude, declination, o.elevation)
+	return o.calcTwilightHighAltitude(o.fajrZenith, declination, o.elevation)
 }
 
 func (o *Option) CalculateSunriseSunsetHighAltitude(declination angle.Angle) angle.Angle {
-	return salatHighAltitude.CalcSalatHighAltitude(angle.NewDegreeFromFloat(consts.SunriseSunsetAngleFactor), o.latitude, declination, o.elevation)
+	sunriseSunsetZenith := angle.NewDegreeFromFloat(consts.SunriseSunsetAngleFactor)
+	if o.sunriseSunsetZenith != nil {
+		sunriseSunsetZenith = *o.sunriseSunsetZenith
+	}
+
+	if semidiameters := o.sunDiscReference.Semidiameters(); semidiameters != 0 {
+		sunriseSunsetZenith = angle.NewDegreeFromFloat(sunriseSunsetZenith.ToDegree().ToFloat() - semidiameters*consts.SunSemidiameterDegree)
+	}
+
+	return salatHighAltitude.CalcSalatHighAltitude(sunriseSunsetZenith, o.solarLatitude(), declination, o.elevation)
 }
 
+// CalculateAsrAngle returns acos((sin(acot(shadowLength + tan|lat - dec|)) - sin(lat)sin(dec)) / (cos(lat)cos(dec))) / 15
 func (o *Option) CalculateAsrAngle(declination angle.Angle) angle.Angle {
-	return trig.Acos((trig.Sin(trig.Acot(o.mazhab.AsrShadowLength()+trig.Tan(o.latitude.Sub(declination).Abs()))) - (trig.Sin(o.latitude) * trig.Sin(declination))) / (trig.Cos(o.latitude) * trig.Cos(declination))).Div(15.)
+	return trig.Acos((trig.Sin(trig.Acot(o.mazhab.AsrShadowLength()+trig.Tan(o.solarLatitude().Sub(declination).Abs()))) - (trig.Sin(o.solarLatitude()) * trig.Sin(declination))) / (trig.Cos(o.solarLatitude()) * trig.Cos(declination))).Div(15.)
 }
 
 func (o *Option) CalculateIshaHighAltitude(declination angle.Angle) (angle.Angle, sunZenithEnum.IshaZenithType) {
 	if o.ishaZenithType == sunZenithEnum.Standard {
-		return salatHighAltitude.CalcSalatHighAltitude(o.ishaZenith, o.latitude, declination, o.elevation), o.ishaZenithType
+		elevation := o.elevation
+		if o.ishaIgnoresElevation {
+			elevation = 0
+		}
+
+		return o.calcTwilightHighAltitude(o.ishaZenith, declination, elevation), o.ishaZenithType
 	}
 
 	return o.ishaZenith, o.ishaZenithType
 }
 
+// CalculateShafaqIsha returns the duration from the sunset to the isha by the shafaq type of the date, when the shafaq type is set
+func (o *Option) CalculateShafaqIsha(date time.Time) (time.Duration, bool) {
+	if o.shafaqType == 0 {
+		return 0, false
+	}
+
+	return shafaq.SeasonAdjustedEveningTwilight(o.latitude.ToDegree().ToFloat(), date, o.shafaqType), true
+}
+
+func (o *Option) CalculateMaghribHighAltitude(declination angle.Angle) angle.Angle {
+	if o.maghribZenith.IsZero() {
+		return angle.Zero
+	}
+
+	return salatHighAltitude.CalcSalatHighAltitude(o.maghribZenith, o.solarLatitude(), declination, o.elevation)
+}
+
+// CalculateDepressionHighAltitude returns the hour angle time from the transit to the sun depressed by the angle below the horizon,
+// without the higher latitude substitution
+func (o *Option) CalculateDepressionHighAltitude(depression, declination angle.Angle) angle.Angle {
+	return salatHighAltitude.CalcSalatHighAltitude(depression, o.solarLatitude(), declination, o.elevation)
+}
+
+// CalculateDhuhrMargin returns the hour angle time from the transit to the dhuhr by the dhuhr definition
+func (o *Option) CalculateDhuhrMargin(declination angle.Angle) angle.Angle {
+	switch o.dhuhrDefinition {
+	case dhuhrDefinitionEnum.Transit:
+		return angle.NewDegreeFromFloat(0.)
+	case dhuhrDefinitionEnum.DeclinePlusMargin:
+		return angle.NewDegreeFromFloat(consts.SunSemidiameterDegree/trig.Cos(declination)/15. + consts.DhuhrSlightMarginMinute/60.)
+	}
+
+	return angle.NewDegreeFromFloat(consts.DhuhrSlightMarginMinute / 60.)
+}
+
+func (o *Option) CalculateSunAltitude(declination, hourAngle angle.Angle) angle.Angle {
+	return trig.Asin(trig.Sin(o.solarLatitude())*trig.Sin(declination) + trig.Cos(o.solarLatitude())*trig.Cos(declination)*trig.Cos(hourAngle))
+}
+
+// CalculateSunAzimuth returns the sun azimuth clockwise from the true north within [0°, 360°)
+func (o *Option) CalculateSunAzimuth(declination, hourAngle angle.Angle) angle.Angle {
+	azimuth := trig.Atan2(trig.Sin(hourAngle), trig.Cos(hourAngle)*trig.Sin(o.solarLatitude())-trig.Tan(declination)*trig.Cos(o.solarLatitude())).ToDegree().ToFloat() + 180.
+
+	return angle.NewDegreeFromFloat(math.Mod(azimuth, 360.))
+}
+
+func (o *Option) CalculateQibla() angle.Angle {
+	return o.calcQiblaFrom(o.latitude, o.eastLongitude())
+}
+
+// CalculateQiblaFrom returns the qibla from the coordinate whose longitude is given in the longitude convention
+func (o *Option) CalculateQiblaFrom(latitude, longitude angle.Angle) angle.Angle {
+	return o.calcQiblaFrom(latitude, toEastPositive(longitude, o.longitudeConvention))
+}
+
+func (o *Option) calcQiblaFrom(latitude, longitude angle.Angle) angle.Angle {
+	reference := o.GetQiblaReference()
+	return qibla.CalcQibla(latitude, longitude, reference.Latitude, reference.Longitude)
+}
+
 func (o *Option) RoundTime(t time.Time) time.Time {
 	return o.roundingTimeOption.RoundTime(t)
 }
 
+func (o *Option) GetGlobalOffset() time.Duration {
+	return o.globalOffset
+}
+
 func (o *Option) GetSunPositions() sunPositions.SunPositions {
 	return o.sunPositions
 }
@@ -214,3 +725,106 @@ func (o *Option) GetSunPositions() sunPositions.SunPositions {
 func (o *Option) GetDateRange() (time.Time, time.Time) {
 	return o.dateStart, o.dateEnd
 }
+
+func (o *Option) Now() time.Time {
+	if o.clock == nil {
+		return time.Now()
+	}
+
+	return o.clock()
+}
+
+func (o *Option) GetTimezone() *time.Location {
+	return o.timezoneLoc
+}
+
+func (o *Option) GetMakruhWidths() (time.Duration, time.Duration) {
+	afterSunrise, beforeSunset := o.makruhAfterSunrise, o.makruhBeforeSunset
+	if afterSunrise == 0 {
+		afterSunrise = time.Duration(consts.MakruhAfterSunriseMinute * float64(time.Minute))
+	}
+
+	if beforeSunset == 0 {
+		beforeSunset = time.Duration(consts.MakruhBeforeSunsetMinute * float64(time.Minute))
+	}
+
+	return afterSunrise, beforeSunset
+}
+
+func (o *Option) GetMinNightFraction() float64 {
+	return o.minNightFraction
+}
+
+func (o *Option) GetTahajjudUntilFajr() bool {
+	return o.tahajjudUntilFajr
+}
+
+func (o *Option) IsExcludedDate(date time.Time) bool {
+	return o.excludedDates[calendarDate(date)]
+}
+
+func (o *Option) GetSpecialHandling(date time.Time) (model.SpecialHandling, bool) {
+	special, ok := o.specialDates[calendarDate(date)]
+	return special, ok
+}
+
+// GetLongitude returns the longitude of the location normalized into the east positive longitude
+func (o *Option) GetLongitude() angle.Angle {
+	return o.eastLongitude()
+}
+
+// GetNearestLatitude returns the reference latitude of the nearest latitude method, 48.5° by default
+func (o *Option) GetNearestLatitude() angle.Angle {
+	if o.nearestLatitude == nil {
+		return angle.NewDegreeFromFloat(consts.NearestLatitudeDegree)
+	}
+
+	return *o.nearestLatitude
+}
+
+func (o *Option) GetZawalWidth() time.Duration {
+	if o.zawalWidth == 0 {
+		return time.Duration(2. * consts.ZawalMarginMinute * float64(time.Minute))
+	}
+
+	return o.zawalWidth
+}
+
+// GetQiblaReference returns the qibla reference coordinate, that is the Kaaba by default
+func (o *Option) GetQiblaReference() model.Coordinate {
+	if o.qiblaReference == nil {
+		return model.Coordinate{
+			Latitude:  angle.NewDegreeFromFloat(consts.KaabaLatitude),
+			Longitude: angle.NewDegreeFromFloat(consts.KaabaLongitude),
+		}
+	}
+
+	return *o.qiblaReference
+}
+
+func (o *Option) GetSalats() []salatEnum.Salat {
+	if len(o.salats) == 0 {
+		return allTimesSalats
+	}
+
+	salats := []salatEnum.Salat{}
+	for _, salat := range allTimesSalats {
+		for _, selected := range o.salats {
+			if selected == salat {
+				salats = append(salats, salat)
+				break
+			}
+		}
+	}
+
+	return salats
+}
+
+func (o Option) ToCommOpt() CommOpt {
+	return CommOpt(o)
+}
+
+func (o *Option) Clone() option.Option {
+	c := *o
+	return &c
+}
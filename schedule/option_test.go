@@ -0,0 +1,274 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/naufalfmm/angle"
+	periodicalEnum "github.com/naufalfmm/moslem-salat-times/enum/periodical"
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	sunZenithEnum "github.com/naufalfmm/moslem-salat-times/enum/sunZenith"
+	"github.com/naufalfmm/moslem-salat-times/option"
+)
+
+func TestSetSunriseSunsetZenith(t *testing.T) {
+	date := time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC)
+
+	sunriseOf := func(opt option.Option) time.Time {
+		sunrises, err := (&Schedule{}).Sunrise(opt)
+		if err != nil {
+			t.Fatalf("Sunrise() error = %v", err)
+		}
+
+		return sunrises[0].RawTime
+	}
+
+	tests := []struct {
+		name     string
+		latitude float64
+		min, max time.Duration
+	}{
+		{"equator", 0., 3*time.Minute + 10*time.Second, 3*time.Minute + 30*time.Second},
+		{"50°N", 50., 4*time.Minute + 50*time.Second, 5*time.Minute + 30*time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			standard := sunriseOf(newTestOption(tt.latitude, 0., time.UTC, date))
+			explicit := sunriseOf(newTestOption(tt.latitude, 0., time.UTC, date).SetSunriseSunsetZenith(angle.NewDegreeFromFloat(0.833)))
+			geometric := sunriseOf(newTestOption(tt.latitude, 0., time.UTC, date).SetSunriseSunsetZenith(angle.NewDegreeFromFloat(0.)))
+
+			if !explicit.Equal(standard) {
+				t.Errorf("sunrise with the 0.833° zenith = %v, want the default %v", explicit, standard)
+			}
+
+			if diff := geometric.Sub(standard); diff < tt.min || diff > tt.max {
+				t.Errorf("geometric sunrise later than the standard by %v, want within [%v, %v]", diff, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func TestSetSalatsSunriseSunset(t *testing.T) {
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	salatTimesOf := func(opt option.Option) map[salatEnum.Salat]time.Time {
+		allSalatTimes, err := (&Schedule{}).AllTimes(opt)
+		if err != nil {
+			t.Fatalf("AllTimes() error = %v", err)
+		}
+
+		salatTimes := map[salatEnum.Salat]time.Time{}
+		for _, salatTime := range allSalatTimes[0].SalatTimes {
+			salatTimes[salatTime.Salat] = salatTime.RawTime
+		}
+
+		return salatTimes
+	}
+
+	all := salatTimesOf(newTestOption(-6.2, 106.8167, time.UTC, date))
+
+	tests := []struct {
+		name   string
+		salats []salatEnum.Salat
+	}{
+		{"without sunrise and sunset", []salatEnum.Salat{salatEnum.Fajr, salatEnum.Dhuhr, salatEnum.Asr, salatEnum.Maghrib, salatEnum.Isha}},
+		{"with sunrise only", []salatEnum.Salat{salatEnum.Fajr, salatEnum.Sunrise, salatEnum.Maghrib}},
+		{"with sunset only", []salatEnum.Salat{salatEnum.Sunset, salatEnum.Maghrib, salatEnum.Isha}},
+		{"in any order", []salatEnum.Salat{salatEnum.Isha, salatEnum.Sunset, salatEnum.Sunrise}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := salatTimesOf(newTestOption(-6.2, 106.8167, time.UTC, date).SetSalats(tt.salats...))
+
+			if len(got) != len(tt.salats) {
+				t.Errorf("AllTimes() salats = %v, want %v", got, tt.salats)
+			}
+
+			for _, salat := range tt.salats {
+				if rawTime, ok := got[salat]; !ok || !rawTime.Equal(all[salat]) {
+					t.Errorf("%s = %v, want %v", salat.Code(), rawTime, all[salat])
+				}
+			}
+		})
+	}
+
+	for _, salat := range []salatEnum.Salat{salatEnum.Sunrise, salatEnum.Sunset} {
+		if _, ok := all[salat]; !ok {
+			t.Errorf("AllTimes() without SetSalats misses the %s", salat.Code())
+		}
+	}
+}
+
+func TestSetSunZenithGulfPresets(t *testing.T) {
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		sunZenith    sunZenithEnum.SunZenith
+		fajr         float64
+		isha         float64
+		ishaType     sunZenithEnum.IshaZenithType
+		ishaInterval time.Duration
+	}{
+		{"dubai", sunZenithEnum.DUBAI, 18.2, 18.2, sunZenithEnum.Standard, 0},
+		{"qatar", sunZenithEnum.QATAR, 18., 1.5, sunZenithEnum.AfterMagrib, 90 * time.Minute},
+		{"kuwait", sunZenithEnum.KUWAIT, 18., 17.5, sunZenithEnum.Standard, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if fajr := tt.sunZenith.FajrZenith().ToDegree().ToFloat(); fajr != tt.fajr {
+				t.Errorf("FajrZenith() = %v, want %v", fajr, tt.fajr)
+			}
+
+			ishaZenith := tt.sunZenith.IshaZenith()
+			if isha := ishaZenith.Angle.ToDegree().ToFloat(); isha != tt.isha || ishaZenith.Type != tt.ishaType {
+				t.Errorf("IshaZenith() = %v %v, want %v %v", isha, ishaZenith.Type, tt.isha, tt.ishaType)
+			}
+
+			opt := newTestOption(25.2, 55.27, time.UTC, date).SetSunZenith(tt.sunZenith)
+
+			fajrs, err := (&Schedule{}).Fajr(opt)
+			if err != nil {
+				t.Fatalf("Fajr() error = %v", err)
+			}
+
+			wantFajrs, err := (&Schedule{}).Fajr(newTestOption(25.2, 55.27, time.UTC, date).
+				SetFajrIshaZenith(angle.NewDegreeFromFloat(tt.fajr), angle.NewDegreeFromFloat(tt.isha)))
+			if err != nil {
+				t.Fatalf("Fajr() error = %v", err)
+			}
+
+			if !fajrs[0].RawTime.Equal(wantFajrs[0].RawTime) {
+				t.Errorf("Fajr() = %v, want %v", fajrs[0].RawTime, wantFajrs[0].RawTime)
+			}
+
+			if tt.ishaInterval == 0 {
+				return
+			}
+
+			ishas, err := (&Schedule{}).Isha(opt)
+			if err != nil {
+				t.Fatalf("Isha() error = %v", err)
+			}
+
+			maghribs, err := (&Schedule{}).Maghrib(opt)
+			if err != nil {
+				t.Fatalf("Maghrib() error = %v", err)
+			}
+
+			if interval := ishas[0].RawTime.Sub(maghribs[0].RawTime); interval < tt.ishaInterval-time.Second || interval > tt.ishaInterval+time.Second {
+				t.Errorf("isha after maghrib = %v, want %v", interval, tt.ishaInterval)
+			}
+		})
+	}
+}
+
+func TestSetClock(t *testing.T) {
+	fixed := time.Date(2024, time.March, 1, 9, 30, 0, 0, time.UTC)
+	clock := func() time.Time { return fixed }
+
+	opt := (&Option{}).SetClock(clock).SetNow()
+	if dateStart, dateEnd := opt.GetDateRange(); !dateStart.Equal(fixed) || !dateEnd.Equal(fixed) {
+		t.Errorf("SetNow() date range = %v - %v, want %v", dateStart, dateEnd, fixed)
+	}
+
+	if now := opt.Now(); !now.Equal(fixed) {
+		t.Errorf("Now() = %v, want %v", now, fixed)
+	}
+
+	commOpt := CommOpt{}
+	for _, applyOpt := range []ApplyCommOpt{WithClock(clock), SetNow()} {
+		applyOpt.Apply(&commOpt)
+	}
+
+	if !commOpt.dateStart.Equal(fixed) || !commOpt.dateEnd.Equal(fixed) {
+		t.Errorf("SetNow() applied date range = %v - %v, want %v", commOpt.dateStart, commOpt.dateEnd, fixed)
+	}
+
+	if now := (&Option{}).Now(); now.Sub(time.Now()) > time.Second || time.Since(now) > time.Second {
+		t.Errorf("Now() without the clock = %v, want the current time", now)
+	}
+}
+
+func TestSetWeekStart(t *testing.T) {
+	wednesday := time.Date(2024, time.March, 6, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		date      time.Time
+		weekStart time.Weekday
+		start     time.Time
+	}{
+		{"saturday", wednesday, time.Saturday, time.Date(2024, time.March, 2, 0, 0, 0, 0, time.UTC)},
+		{"sunday", wednesday, time.Sunday, time.Date(2024, time.March, 3, 0, 0, 0, 0, time.UTC)},
+		{"monday", wednesday, time.Monday, time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC)},
+		{"on the week start", wednesday, time.Wednesday, wednesday},
+		{"across the month", time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), time.Monday, time.Date(2024, time.February, 26, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := newTestOption(-6.2, 106.8167, time.UTC, tt.date).SetWeekStart(tt.weekStart).SetDatePeriodical(tt.date, periodicalEnum.Weekly)
+			after := newTestOption(-6.2, 106.8167, time.UTC, tt.date).SetDatePeriodical(tt.date, periodicalEnum.Weekly).SetWeekStart(tt.weekStart)
+
+			for _, opt := range []option.Option{before, after} {
+				dateStart, dateEnd := opt.GetDateRange()
+				if !dateStart.Equal(tt.start) || !dateEnd.Equal(tt.start.AddDate(0, 0, 6)) {
+					t.Errorf("GetDateRange() = %v - %v, want %v - %v", dateStart, dateEnd, tt.start, tt.start.AddDate(0, 0, 6))
+				}
+
+				allSalatTimes, err := (&Schedule{}).AllTimes(opt)
+				if err != nil {
+					t.Fatalf("AllTimes() error = %v", err)
+				}
+
+				if len(allSalatTimes) != 7 || allSalatTimes[0].Date.Weekday() != tt.weekStart {
+					t.Errorf("AllTimes() = %d days from %v, want 7 days from the %s", len(allSalatTimes), allSalatTimes[0].Date.Weekday(), tt.weekStart)
+				}
+			}
+		})
+	}
+}
+
+func TestSetDateRangeExclusive(t *testing.T) {
+	jakarta := time.FixedZone("0700", 7*60*60)
+	dateOf := func(day int) time.Time {
+		return time.Date(2024, time.March, day, 0, 0, 0, 0, jakarta)
+	}
+
+	tests := []struct {
+		name      string
+		dateStart time.Time
+		dateEnd   time.Time
+		wantDays  []int
+	}{
+		{"first of chained ranges", dateOf(1), dateOf(4), []int{1, 2, 3}},
+		{"second of chained ranges", dateOf(4), dateOf(6), []int{4, 5}},
+		{"single day", dateOf(1), dateOf(2), []int{1}},
+		{"empty range", dateOf(1), dateOf(1), []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opt := newTestOption(-6.2088, 106.8456, jakarta, tt.dateStart).SetDateRangeExclusive(tt.dateStart, tt.dateEnd)
+
+			allSalatTimes, err := (&Schedule{}).AllTimes(opt)
+			if err != nil {
+				t.Fatalf("AllTimes() error = %v", err)
+			}
+
+			if len(allSalatTimes) != len(tt.wantDays) {
+				t.Fatalf("AllTimes() = %d days, want %d", len(allSalatTimes), len(tt.wantDays))
+			}
+
+			for i, allSalatTime := range allSalatTimes {
+				if allSalatTime.Date.Day() != tt.wantDays[i] {
+					t.Errorf("AllTimes()[%d] date = %v, want day %d", i, allSalatTime.Date, tt.wantDays[i])
+				}
+			}
+		})
+	}
+}
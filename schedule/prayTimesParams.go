@@ -0,0 +1,296 @@
+package schedule
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/naufalfmm/angle"
+	"github.com/naufalfmm/moslem-salat-times/consts"
+	higherLatEnum "github.com/naufalfmm/moslem-salat-times/enum/higherLat"
+	mazhabEnum "github.com/naufalfmm/moslem-salat-times/enum/mazhab"
+	sunZenithEnum "github.com/naufalfmm/moslem-salat-times/enum/sunZenith"
+	"github.com/naufalfmm/moslem-salat-times/err"
+	"github.com/naufalfmm/moslem-salat-times/option"
+)
+
+const prayTimesMinuteSuffix = "min"
+
+var prayTimesMethods = map[string]sunZenithEnum.SunZenith{
+	"MWL":     sunZenithEnum.MWL,
+	"ISNA":    sunZenithEnum.ISNA,
+	"EGYPT":   sunZenithEnum.ESA,
+	"MAKKAH":  sunZenithEnum.UAU,
+	"KARACHI": sunZenithEnum.UIS,
+	"TEHRAN":  sunZenithEnum.TEHRAN,
+}
+
+// prayTimesTuneKeys are the times tuned by the PrayTimes.org tune parameter
+var prayTimesTuneKeys = []string{"imsak", "fajr", "sunrise", "dhuhr", "asr", "sunset", "maghrib", "isha", "midnight"}
+
+// jafari is the PrayTimes.org Shia Ithna-Ashari method which has no sun zenith constant here
+var jafari = struct {
+	fajr, isha, maghrib float64
+}{16., 14., 4.}
+
+// NewFromPrayTimesParams builds the option from the PrayTimes.org parameters, i.e. method, fajr, isha, maghrib, asr, highLats, midnight, imsak, adjust, and tune.
+// The adjust holds the nested parameters applied after the others and the tune is only supported when every salat is tuned by the same minutes.
+// The unknown key fails on the strict mode and is ignored otherwise. The date range and the location are left for the caller.
+func NewFromPrayTimesParams(params map[string]interface{}, strict bool) (option.Option, error) {
+	opt := &Option{}
+	if applyErr := opt.applyPrayTimesParams(params, strict); applyErr != nil {
+		return nil, applyErr
+	}
+
+	return opt, nil
+}
+
+func (o *Option) applyPrayTimesParams(params map[string]interface{}, strict bool) error {
+	if method, ok := params["method"]; ok {
+		if applyErr := o.applyPrayTimesMethod(method); applyErr != nil {
+			return applyErr
+		}
+	}
+
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		if key != "method" && key != "adjust" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if applyErr := o.applyPrayTimesParam(key, params[key], strict); applyErr != nil {
+			return applyErr
+		}
+	}
+
+	adjust, ok := params["adjust"]
+	if !ok {
+		return nil
+	}
+
+	adjustParams, ok := adjust.(map[string]interface{})
+	if !ok {
+		return err.ErrInvalidPrayTimesParam
+	}
+
+	return o.applyPrayTimesParams(adjustParams, strict)
+}
+
+func (o *Option) applyPrayTimesMethod(value interface{}) error {
+	method, ok := value.(string)
+	if !ok {
+		return err.ErrInvalidPrayTimesParam
+	}
+
+	if strings.EqualFold(method, "Jafari") {
+		o.SetFajrIshaZenith(angle.NewDegreeFromFloat(jafari.fajr), angle.NewDegreeFromFloat(jafari.isha))
+		o.SetMaghribZenith(angle.NewDegreeFromFloat(jafari.maghrib))
+
+		return nil
+	}
+
+	sunZenith, ok := prayTimesMethods[strings.ToUpper(method)]
+	if !ok {
+		return err.ErrInvalidPrayTimesParam
+	}
+
+	o.SetSunZenith(sunZenith)
+
+	return nil
+}
+
+func (o *Option) applyPrayTimesParam(key string, value interface{}, strict bool) error {
+	switch key {
+	case "fajr":
+		degree, isMinute, parseErr := parsePrayTimesValue(value)
+		if parseErr != nil {
+			return parseErr
+		}
+
+		if isMinute {
+			return err.ErrOptionNotSupported
+		}
+
+		o.fajrZenith = angle.NewDegreeFromFloat(degree)
+	case "isha":
+		val, isMinute, parseErr := parsePrayTimesValue(value)
+		if parseErr != nil {
+			return parseErr
+		}
+
+		o.ishaZenith = angle.NewDegreeFromFloat(val)
+		o.ishaZenithType = sunZenithEnum.Standard
+		if isMinute {
+			o.ishaZenith = angle.NewDegreeFromFloat(val / 60.)
+			o.ishaZenithType = sunZenithEnum.AfterMagrib
+		}
+	case "maghrib":
+		val, isMinute, parseErr := parsePrayTimesValue(value)
+		if parseErr != nil {
+			return parseErr
+		}
+
+		// the maghrib by minutes is only the sunset itself, which is followed by the slight margin here
+		if isMinute && val != 0 {
+			return err.ErrOptionNotSupported
+		}
+
+		o.maghribZenith = angle.Zero
+		if !isMinute {
+			o.maghribZenith = angle.NewDegreeFromFloat(val)
+		}
+	case "asr":
+		mazhab, parseErr := parsePrayTimesMazhab(value)
+		if parseErr != nil {
+			return parseErr
+		}
+
+		o.mazhab = mazhab
+	case "highLats":
+		name, ok := value.(string)
+		if !ok {
+			return err.ErrInvalidPrayTimesParam
+		}
+
+		higherLat, ok := findHigherLat(name)
+		if !ok {
+			return err.ErrInvalidPrayTimesParam
+		}
+
+		o.higherLatitudeMethod = higherLat
+	case "midnight":
+		if name, ok := value.(string); !ok || !strings.EqualFold(name, "Standard") {
+			return err.ErrOptionNotSupported
+		}
+	case "imsak":
+		val, isMinute, parseErr := parsePrayTimesValue(value)
+		if parseErr != nil {
+			return parseErr
+		}
+
+		if !isMinute || val != consts.ImsakBeforeFajrMinute {
+			return err.ErrOptionNotSupported
+		}
+	case "tune":
+		offset, tuneErr := parsePrayTimesTune(value)
+		if tuneErr != nil {
+			return tuneErr
+		}
+
+		o.globalOffset = offset
+	default:
+		if strict {
+			return err.ErrUnknownPrayTimesParam
+		}
+	}
+
+	return nil
+}
+
+// parsePrayTimesValue parses the number or the string of the degree, e.g. 18 or "18", or of the minutes, e.g. "90 min"
+func parsePrayTimesValue(value interface{}) (float64, bool, error) {
+	switch val := value.(type) {
+	case float64:
+		return val, false, nil
+	case int:
+		return float64(val), false, nil
+	case string:
+		str := strings.TrimSpace(val)
+
+		isMinute := strings.HasSuffix(str, prayTimesMinuteSuffix)
+		if isMinute {
+			str = strings.TrimSpace(strings.TrimSuffix(str, prayTimesMinuteSuffix))
+		}
+
+		num, parseErr := strconv.ParseFloat(str, 64)
+		if parseErr != nil {
+			return 0, false, err.ErrInvalidPrayTimesParam
+		}
+
+		return num, isMinute, nil
+	}
+
+	return 0, false, err.ErrInvalidPrayTimesParam
+}
+
+// parsePrayTimesMazhab parses the juristic name, e.g. "Hanafi", or the shadow factor, e.g. 2
+func parsePrayTimesMazhab(value interface{}) (mazhabEnum.Mazhab, error) {
+	if name, ok := value.(string); ok {
+		for _, mazhab := range []mazhabEnum.Mazhab{mazhabEnum.Standard, mazhabEnum.Hanafi} {
+			if strings.EqualFold(name, mazhab.Name()) {
+				return mazhab, nil
+			}
+		}
+	}
+
+	factor, isMinute, parseErr := parsePrayTimesValue(value)
+	if parseErr != nil || isMinute {
+		return 0, err.ErrInvalidPrayTimesParam
+	}
+
+	for _, mazhab := range []mazhabEnum.Mazhab{mazhabEnum.Standard, mazhabEnum.Hanafi} {
+		if factor == mazhab.AsrShadowLength() {
+			return mazhab, nil
+		}
+	}
+
+	return 0, err.ErrOptionNotSupported
+}
+
+func isPrayTimesTuneKey(key string) bool {
+	for _, tuneKey := range prayTimesTuneKeys {
+		if key == tuneKey {
+			return true
+		}
+	}
+
+	return false
+}
+
+func findHigherLat(name string) (higherLatEnum.HigherLat, bool) {
+	for _, higherLat := range []higherLatEnum.HigherLat{higherLatEnum.NightMiddle, higherLatEnum.OneSeventh, higherLatEnum.AngleBased, higherLatEnum.None} {
+		if strings.EqualFold(name, higherLat.Code()) {
+			return higherLat, true
+		}
+	}
+
+	return 0, false
+}
+
+// parsePrayTimesTune parses the minutes tuning each salat, which must be the same for all of them.
+// The salat left out is tuned by zero minutes like PrayTimes.org, so the partial tune is not supported.
+func parsePrayTimesTune(value interface{}) (time.Duration, error) {
+	tunes, ok := value.(map[string]interface{})
+	if !ok {
+		return 0, err.ErrInvalidPrayTimesParam
+	}
+
+	for key := range tunes {
+		if !isPrayTimesTuneKey(key) {
+			return 0, err.ErrInvalidPrayTimesParam
+		}
+	}
+
+	var minutes float64
+	for i, key := range prayTimesTuneKeys {
+		var val float64
+		if tune, ok := tunes[key]; ok {
+			var parseErr error
+			if val, _, parseErr = parsePrayTimesValue(tune); parseErr != nil {
+				return 0, parseErr
+			}
+		}
+
+		if i > 0 && val != minutes {
+			return 0, err.ErrOptionNotSupported
+		}
+
+		minutes = val
+	}
+
+	return time.Duration(minutes * float64(time.Minute)), nil
+}
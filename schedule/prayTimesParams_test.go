@@ -0,0 +1,78 @@
+package schedule
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/naufalfmm/angle"
+	"github.com/naufalfmm/moslem-salat-times/err"
+	"github.com/naufalfmm/moslem-salat-times/model"
+)
+
+func TestNewFromPrayTimesParamsTune(t *testing.T) {
+	jakarta := time.FixedZone("0700", 7*60*60)
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, jakarta)
+
+	allTimesOf := func(tune map[string]interface{}) (model.PeriodicAllSalatTime, error) {
+		params := map[string]interface{}{"method": "MWL", "asr": "Standard"}
+		if tune != nil {
+			params["tune"] = tune
+		}
+
+		opt, paramsErr := NewFromPrayTimesParams(params, true)
+		if paramsErr != nil {
+			return nil, paramsErr
+		}
+
+		return (&Schedule{}).AllTimes(opt.
+			SetLatitudeLongitude(angle.NewDegreeFromFloat(-6.2088), angle.NewDegreeFromFloat(106.8456)).
+			SetTimezone(jakarta).
+			SetDateRange(date, date))
+	}
+
+	untuned, calcErr := allTimesOf(nil)
+	if calcErr != nil {
+		t.Fatalf("AllTimes() untuned error = %v", calcErr)
+	}
+
+	fullTune := map[string]interface{}{}
+	for _, key := range prayTimesTuneKeys {
+		fullTune[key] = 2
+	}
+
+	tests := []struct {
+		name       string
+		tune       map[string]interface{}
+		wantOffset time.Duration
+		wantErr    error
+	}{
+		{"full tune", fullTune, 2 * time.Minute, nil},
+		{"zero partial tune", map[string]interface{}{"fajr": 0}, 0, nil},
+		{"partial tune", map[string]interface{}{"fajr": 2}, 0, err.ErrOptionNotSupported},
+		{"unknown key", map[string]interface{}{"foo": 2}, 0, err.ErrInvalidPrayTimesParam},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tuned, calcErr := allTimesOf(tt.tune)
+			if tt.wantErr != nil {
+				if !errors.Is(calcErr, tt.wantErr) {
+					t.Errorf("NewFromPrayTimesParams() error = %v, want %v", calcErr, tt.wantErr)
+				}
+
+				return
+			}
+
+			if calcErr != nil {
+				t.Fatalf("AllTimes() error = %v", calcErr)
+			}
+
+			for i, salatTime := range tuned[0].SalatTimes {
+				if got := salatTime.RawTime.Sub(untuned[0].SalatTimes[i].RawTime); got != tt.wantOffset {
+					t.Errorf("%s tuned by %v, want %v", salatTime.Salat.Code(), got, tt.wantOffset)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package schedule
+
+import (
+	"sort"
+	"time"
+
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	"github.com/naufalfmm/moslem-salat-times/model"
+	"github.com/naufalfmm/moslem-salat-times/option"
+)
+
+var progressSalats = []salatEnum.Salat{
+	salatEnum.Fajr,
+	salatEnum.Sunrise,
+	salatEnum.Dhuhr,
+	salatEnum.Asr,
+	salatEnum.Maghrib,
+	salatEnum.Isha,
+}
+
+// PrayerProgress returns the current prayer and the elapsed fraction of its window until the next prayer.
+// Before the fajr, the current prayer is the isha of the previous day.
+// The sunrise is a window too, so between the sunrise and the dhuhr, when no salat is due, the current prayer is the sunrise.
+func (s *Schedule) PrayerProgress(opt option.Option, now time.Time) (salatEnum.Salat, float64, error) {
+	if err := opt.ValidateBySalat(0); err != nil {
+		return 0, 0, err
+	}
+
+	date := now.In(opt.GetTimezone())
+
+	allSalatTimes, err := s.allTimes(opt.Clone().SetDateRange(date.AddDate(0, 0, -1), date.AddDate(0, 0, 1)).SetSalats(progressSalats...))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	salatTimes := model.PeriodicSalatTime{}
+	for _, allSalatTime := range allSalatTimes {
+		for _, salatTime := range allSalatTime.SalatTimes {
+			if !salatTime.Unavailable {
+				salatTimes = append(salatTimes, salatTime)
+			}
+		}
+	}
+
+	sort.SliceStable(salatTimes, func(i, j int) bool {
+		return salatTimes[i].Time.Before(salatTimes[j].Time)
+	})
+
+	for i := 1; i < len(salatTimes); i++ {
+		if now.Before(salatTimes[i].Time) {
+			current, next := salatTimes[i-1], salatTimes[i]
+
+			return current.Salat, float64(now.Sub(current.Time)) / float64(next.Time.Sub(current.Time)), nil
+		}
+	}
+
+	return 0, 0, nil
+}
@@ -0,0 +1,59 @@
+package schedule
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+)
+
+func TestPrayerProgress(t *testing.T) {
+	jakarta := time.FixedZone("0700", 7*60*60)
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, jakarta)
+	opt := newTestOption(-6.2088, 106.8456, jakarta, date)
+
+	salatTimesOf := func(date time.Time) map[salatEnum.Salat]time.Time {
+		allSalatTimes, calcErr := (&Schedule{}).allTimes(opt.Clone().SetDateRange(date, date).SetSalats(progressSalats...))
+		if calcErr != nil {
+			t.Fatalf("allTimes() error = %v", calcErr)
+		}
+
+		salatTimes := map[salatEnum.Salat]time.Time{}
+		for _, salatTime := range allSalatTimes[0].SalatTimes {
+			salatTimes[salatTime.Salat] = salatTime.Time
+		}
+
+		return salatTimes
+	}
+
+	yesterday, today := salatTimesOf(date.AddDate(0, 0, -1)), salatTimesOf(date)
+	middle := func(start, end time.Time) time.Time {
+		return start.Add(end.Sub(start) / 2)
+	}
+
+	tests := []struct {
+		name         string
+		now          time.Time
+		wantSalat    salatEnum.Salat
+		wantProgress float64
+	}{
+		{"before fajr", middle(yesterday[salatEnum.Isha], today[salatEnum.Fajr]), salatEnum.Isha, 0.5},
+		{"at fajr", today[salatEnum.Fajr], salatEnum.Fajr, 0},
+		{"between sunrise and dhuhr", middle(today[salatEnum.Sunrise], today[salatEnum.Dhuhr]), salatEnum.Sunrise, 0.5},
+		{"mid asr", middle(today[salatEnum.Asr], today[salatEnum.Maghrib]), salatEnum.Asr, 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			salat, progress, calcErr := (&Schedule{}).PrayerProgress(opt, tt.now)
+			if calcErr != nil {
+				t.Fatalf("PrayerProgress() error = %v", calcErr)
+			}
+
+			if salat != tt.wantSalat || math.Abs(progress-tt.wantProgress) > 1e-6 {
+				t.Errorf("PrayerProgress() = %s, %v, want %s, %v", salat.Code(), progress, tt.wantSalat.Code(), tt.wantProgress)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package schedule
+
+import (
+	"math"
+	"time"
+
+	"github.com/naufalfmm/angle"
+	"github.com/naufalfmm/moslem-salat-times/err"
+	"github.com/naufalfmm/moslem-salat-times/model"
+	"github.com/naufalfmm/moslem-salat-times/option"
+)
+
+func (s *Schedule) Qibla(opt option.Option) (angle.Angle, error) {
+	if err := opt.ValidateBySalat(0); err != nil {
+		return angle.Angle{}, err
+	}
+
+	return opt.CalculateQibla(), nil
+}
+
+// QiblaError returns the signed difference of the qibla from the actual bearing within (-180°, 180°], that is the qibla minus the actual bearing.
+// The positive error means the actual bearing has to turn clockwise to face the qibla.
+func (s *Schedule) QiblaError(opt option.Option, actualBearing angle.Angle) (angle.Angle, error) {
+	if err := opt.ValidateBySalat(0); err != nil {
+		return angle.Angle{}, err
+	}
+
+	if actualBearing == (angle.Angle{}) || math.IsNaN(actualBearing.ToDegree().ToFloat()) {
+		return angle.Angle{}, err.ErrInvalidBearing
+	}
+
+	diff := math.Mod(math.Mod(opt.CalculateQibla().ToDegree().ToFloat()-actualBearing.ToDegree().ToFloat(), 360.)+360., 360.)
+	if diff > 180. {
+		diff -= 360.
+	}
+
+	return angle.NewDegreeFromFloat(diff), nil
+}
+
+// QiblaAlongRoute returns the qibla bearing at each point of the route
+func (s *Schedule) QiblaAlongRoute(opt option.Option, route []model.Coordinate) []angle.Angle {
+	bearings := make([]angle.Angle, len(route))
+	for i, point := range route {
+		bearings[i] = opt.CalculateQiblaFrom(point.Latitude, point.Longitude)
+	}
+
+	return bearings
+}
+
+func (s *Schedule) AllTimesWithQibla(opt option.Option, date time.Time) (model.AllSalatTime, angle.Angle, error) {
+	allSalatTimes, calcErr := s.AllTimes(opt.Clone().SetDateRange(date, date))
+	if calcErr != nil {
+		return model.AllSalatTime{}, angle.Angle{}, calcErr
+	}
+
+	if len(allSalatTimes) == 0 {
+		return model.AllSalatTime{}, angle.Angle{}, err.ErrDateExcluded
+	}
+
+	return allSalatTimes[0], opt.CalculateQibla(), nil
+}
@@ -0,0 +1,41 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAllTimesWithQibla(t *testing.T) {
+	jakarta := time.FixedZone("0700", 7*60*60)
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, jakarta)
+	opt := newTestOption(-6.2088, 106.8456, jakarta, date.AddDate(0, 0, -3))
+
+	allSalatTime, qibla, err := (&Schedule{}).AllTimesWithQibla(opt, date)
+	if err != nil {
+		t.Fatalf("AllTimesWithQibla() error = %v", err)
+	}
+
+	allSalatTimes, err := (&Schedule{}).AllTimes(opt.Clone().SetDateRange(date, date))
+	if err != nil {
+		t.Fatalf("AllTimes() error = %v", err)
+	}
+
+	wantQibla, err := (&Schedule{}).Qibla(opt)
+	if err != nil {
+		t.Fatalf("Qibla() error = %v", err)
+	}
+
+	if got, want := qibla.ToDegree().ToFloat(), wantQibla.ToDegree().ToFloat(); got != want {
+		t.Errorf("AllTimesWithQibla() qibla = %v°, want %v°", got, want)
+	}
+
+	if !allSalatTime.Date.Equal(allSalatTimes[0].Date) || len(allSalatTime.SalatTimes) != len(allSalatTimes[0].SalatTimes) {
+		t.Fatalf("AllTimesWithQibla() = %+v, want %+v", allSalatTime, allSalatTimes[0])
+	}
+
+	for i, salatTime := range allSalatTime.SalatTimes {
+		if !salatTime.Time.Equal(allSalatTimes[0].SalatTimes[i].Time) {
+			t.Errorf("%s = %v, want %v", salatTime.Salat.Code(), salatTime.Time, allSalatTimes[0].SalatTimes[i].Time)
+		}
+	}
+}
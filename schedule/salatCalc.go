@@ -1,6 +1,8 @@
 package schedule
 
 import (
+	"math"
+	"sort"
 	"time"
 
 	"github.com/naufalfmm/angle"
@@ -12,6 +14,10 @@ import (
 	"github.com/naufalfmm/moslem-salat-times/utils/sunPositions"
 )
 
+func isAngleUndefined(ang angle.Angle) bool {
+	return math.IsNaN(ang.ToDegree().ToFloat())
+}
+
 func sunriseAngleTime(opt option.Option, sunPos sunPositions.SunPosition) angle.Angle {
 	return sunPos.SunTransitTime.Sub(opt.CalculateSunriseSunsetHighAltitude(sunPos.Declination))
 }
@@ -21,9 +27,128 @@ func sunsetAngleTime(opt option.Option, sunPos sunPositions.SunPosition) angle.A
 }
 
 func maghribAngleTime(opt option.Option, sunPos sunPositions.SunPosition) angle.Angle {
+	if maghribHighAlt := opt.CalculateMaghribHighAltitude(sunPos.Declination); !maghribHighAlt.IsZero() {
+		return sunPos.SunTransitTime.Add(maghribHighAlt)
+	}
+
 	return sunsetAngleTime(opt, sunPos).Add(angle.NewDegreeFromFloat(consts.MaghribSlightMarginMinute / 60.))
 }
 
+// angleTimeOnDate places the hour angle time on the date's own calendar day and location
+func angleTimeOnDate(angTime angle.Angle, date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, int(math.Floor(angTime.ToDegree().ToFloat()*3600.)), 0, date.Location())
+}
+
+func fajrAngleTime(opt option.Option, sunPos sunPositions.SunPosition) angle.Angle {
+	fajr, _ := clampedNight(opt, sunPos)
+	return fajr
+}
+
+func rawFajrAngleTime(opt option.Option, sunPos sunPositions.SunPosition) angle.Angle {
+	return sunPos.SunTransitTime.Sub(opt.CalculateFajrHighAltitude(sunPos.Declination))
+}
+
+// clampedNight returns the fajr and isha with the night between the isha and the next fajr, taken on the same sun position,
+// widened around its middle to the minimum night fraction of the day. The night is left as is when either one is undefined or missing.
+func clampedNight(opt option.Option, sunPos sunPositions.SunPosition) (angle.Angle, angle.Angle) {
+	minNightFraction := opt.GetMinNightFraction()
+	if minNightFraction <= 0 || opt.ValidateBySalat(salatEnum.Fajr) != nil || opt.ValidateBySalat(salatEnum.Isha) != nil {
+		return rawFajrAngleTime(opt, sunPos), rawIshaAngleTime(opt, sunPos)
+	}
+
+	fajr, isha := rawFajrAngleTime(opt, sunPos), rawIshaAngleTime(opt, sunPos)
+	if isAngleUndefined(fajr) || isAngleUndefined(isha) {
+		return fajr, isha
+	}
+
+	fajrHour, ishaHour := fajr.ToDegree().ToFloat(), isha.ToDegree().ToFloat()
+	minNight := minNightFraction * 24.
+	if fajrHour+24.-ishaHour >= minNight {
+		return fajr, isha
+	}
+
+	middle := (ishaHour + fajrHour + 24.) / 2.
+
+	return angle.NewDegreeFromFloat(middle + minNight/2. - 24.), angle.NewDegreeFromFloat(middle - minNight/2.)
+}
+
+func dhuhrAngleTime(opt option.Option, sunPos sunPositions.SunPosition) angle.Angle {
+	return sunPos.SunTransitTime.Add(opt.CalculateDhuhrMargin(sunPos.Declination))
+}
+
+func asrAngleTime(opt option.Option, sunPos sunPositions.SunPosition) angle.Angle {
+	return sunPos.SunTransitTime.Add(opt.CalculateAsrAngle(sunPos.Declination))
+}
+
+func ishaAngleTime(opt option.Option, sunPos sunPositions.SunPosition) angle.Angle {
+	_, isha := clampedNight(opt, sunPos)
+	return isha
+}
+
+func rawIshaAngleTime(opt option.Option, sunPos sunPositions.SunPosition) angle.Angle {
+	if ishaAfterSunset, ok := opt.CalculateShafaqIsha(sunPos.Date); ok {
+		return sunsetAngleTime(opt, sunPos).AddScalar(ishaAfterSunset.Hours())
+	}
+
+	ishaHighAlt, ishaType := opt.CalculateIshaHighAltitude(sunPos.Declination)
+
+	if ishaType == sunZenithEnum.AfterMagrib {
+		return maghribAngleTime(opt, sunPos).Add(ishaHighAlt)
+	}
+
+	return sunPos.SunTransitTime.Add(ishaHighAlt)
+}
+
+// salatAngleTime returns the hour angle time of the salat which only depends on the sun position of the day
+func salatAngleTime(opt option.Option, salat salatEnum.Salat, sunPos sunPositions.SunPosition) (angle.Angle, bool) {
+	angleTimeFuncs := map[salatEnum.Salat]func(opt option.Option, sunPos sunPositions.SunPosition) angle.Angle{
+		salatEnum.Fajr:    fajrAngleTime,
+		salatEnum.Sunrise: sunriseAngleTime,
+		salatEnum.Dhuhr:   dhuhrAngleTime,
+		salatEnum.Asr:     asrAngleTime,
+		salatEnum.Sunset:  sunsetAngleTime,
+		salatEnum.Maghrib: maghribAngleTime,
+		salatEnum.Isha:    ishaAngleTime,
+	}
+
+	angleTimeFunc, ok := angleTimeFuncs[salat]
+	if !ok {
+		return angle.Angle{}, false
+	}
+
+	return angleTimeFunc(opt, sunPos), true
+}
+
+// isSalatUndefined reports whether the salat time does not exist on the day, e.g. the sun never reaches the zenith angle.
+// The midnight follows the sunrise.
+func isSalatUndefined(opt option.Option, salat salatEnum.Salat, sunPos sunPositions.SunPosition) bool {
+	if salat == salatEnum.Midnight {
+		salat = salatEnum.Sunrise
+	}
+
+	angTime, ok := salatAngleTime(opt, salat, sunPos)
+	return ok && isAngleUndefined(angTime)
+}
+
+func newSalatTime(opt option.Option, date time.Time, salat salatEnum.Salat, angTime angle.Angle) model.SalatTime {
+	if isAngleUndefined(angTime) {
+		return model.SalatTime{
+			Date:        date,
+			Salat:       salat,
+			Unavailable: true,
+		}
+	}
+
+	rawTime := angleTimeOnDate(angTime, date).Add(opt.GetGlobalOffset())
+
+	return model.SalatTime{
+		Date:    date,
+		Salat:   salat,
+		Time:    opt.RoundTime(rawTime),
+		RawTime: rawTime,
+	}
+}
+
 func (s *Schedule) Midnight(opt option.Option) (model.PeriodicSalatTime, error) {
 	if err := opt.ValidateBySalat(salatEnum.Isha); err != nil {
 		return model.PeriodicSalatTime{}, err
@@ -36,11 +161,9 @@ func (s *Schedule) Midnight(opt option.Option) (model.PeriodicSalatTime, error)
 
 	periodicSalatTimes := make(model.PeriodicSalatTime, len(opt.GetSunPositions()))
 	for i, sunPosition := range opt.GetSunPositions() {
-		dateStart, _ := opt.GetDateRange()
-		dateStart = dateStart.Add(-24 * time.Hour)
+		yesterday := sunPosition.Date.Add(-24 * time.Hour)
 
-		yestSundayOpt := opt
-		yestSundayOpt, err := yestSundayOpt.SetDateRange(dateStart, dateStart).CalculateSunPositions()
+		yestSundayOpt, err := opt.Clone().SetDateRange(yesterday, yesterday).CalculateSunPositions()
 		if err != nil {
 			return nil, err
 		}
@@ -48,11 +171,7 @@ func (s *Schedule) Midnight(opt option.Option) (model.PeriodicSalatTime, error)
 		yestSunset := sunsetAngleTime(yestSundayOpt, yestSundayOpt.GetSunPositions()[0])
 		todaySunrise := sunriseAngleTime(opt, sunPosition)
 
-		periodicSalatTimes[i] = model.SalatTime{
-			Date:  sunPosition.Date,
-			Salat: salatEnum.Midnight,
-			Time:  opt.RoundTime(yestSunset.Add(angle.NewFromDegreeMinuteSecond(24., 0., 0.).ToDegree().Sub(yestSunset).Add(todaySunrise).Div(2.)).ToTime()),
-		}
+		periodicSalatTimes[i] = newSalatTime(opt, sunPosition.Date, salatEnum.Midnight, yestSunset.Add(angle.NewFromDegreeMinuteSecond(24., 0., 0.).ToDegree().Sub(yestSunset).Add(todaySunrise).Div(2.)).SubScalar(24.))
 	}
 
 	return periodicSalatTimes, nil
@@ -70,11 +189,7 @@ func (s *Schedule) Fajr(opt option.Option) (model.PeriodicSalatTime, error) {
 
 	periodicSalatTimes := make(model.PeriodicSalatTime, len(opt.GetSunPositions()))
 	for i, sunPosition := range opt.GetSunPositions() {
-		periodicSalatTimes[i] = model.SalatTime{
-			Date:  sunPosition.Date,
-			Salat: salatEnum.Fajr,
-			Time:  opt.RoundTime(sunPosition.SunTransitTime.Sub(opt.CalculateFajrHighAltitude(sunPosition.Declination)).ToTime()),
-		}
+		periodicSalatTimes[i] = newSalatTime(opt, sunPosition.Date, salatEnum.Fajr, fajrAngleTime(opt, sunPosition))
 	}
 
 	return periodicSalatTimes, nil
@@ -92,11 +207,7 @@ func (s *Schedule) Sunrise(opt option.Option) (model.PeriodicSalatTime, error) {
 
 	periodicSalatTimes := make(model.PeriodicSalatTime, len(opt.GetSunPositions()))
 	for i, sunPosition := range opt.GetSunPositions() {
-		periodicSalatTimes[i] = model.SalatTime{
-			Date:  sunPosition.Date,
-			Salat: salatEnum.Sunrise,
-			Time:  opt.RoundTime(sunriseAngleTime(opt, sunPosition).ToTime()),
-		}
+		periodicSalatTimes[i] = newSalatTime(opt, sunPosition.Date, salatEnum.Sunrise, sunriseAngleTime(opt, sunPosition))
 	}
 
 	return periodicSalatTimes, nil
@@ -114,11 +225,7 @@ func (s *Schedule) Dhuhr(opt option.Option) (model.PeriodicSalatTime, error) {
 
 	periodicSalatTimes := make(model.PeriodicSalatTime, len(opt.GetSunPositions()))
 	for i, sunPosition := range opt.GetSunPositions() {
-		periodicSalatTimes[i] = model.SalatTime{
-			Date:  sunPosition.Date,
-			Salat: salatEnum.Dhuhr,
-			Time:  opt.RoundTime(sunPosition.SunTransitTime.AddScalar(consts.DhuhrSlightMarginMinute / 60.).ToTime()),
-		}
+		periodicSalatTimes[i] = newSalatTime(opt, sunPosition.Date, salatEnum.Dhuhr, dhuhrAngleTime(opt, sunPosition))
 	}
 
 	return periodicSalatTimes, nil
@@ -136,11 +243,7 @@ func (s *Schedule) Asr(opt option.Option) (model.PeriodicSalatTime, error) {
 
 	periodicSalatTimes := make(model.PeriodicSalatTime, len(opt.GetSunPositions()))
 	for i, sunPosition := range opt.GetSunPositions() {
-		periodicSalatTimes[i] = model.SalatTime{
-			Date:  sunPosition.Date,
-			Salat: salatEnum.Asr,
-			Time:  opt.RoundTime(sunPosition.SunTransitTime.Add(opt.CalculateAsrAngle(sunPosition.Declination)).ToTime()),
-		}
+		periodicSalatTimes[i] = newSalatTime(opt, sunPosition.Date, salatEnum.Asr, asrAngleTime(opt, sunPosition))
 	}
 
 	return periodicSalatTimes, nil
@@ -158,11 +261,7 @@ func (s *Schedule) Sunset(opt option.Option) (model.PeriodicSalatTime, error) {
 
 	periodicSalatTimes := make(model.PeriodicSalatTime, len(opt.GetSunPositions()))
 	for i, sunPosition := range opt.GetSunPositions() {
-		periodicSalatTimes[i] = model.SalatTime{
-			Date:  sunPosition.Date,
-			Salat: salatEnum.Sunset,
-			Time:  opt.RoundTime(sunsetAngleTime(opt, sunPosition).ToTime()),
-		}
+		periodicSalatTimes[i] = newSalatTime(opt, sunPosition.Date, salatEnum.Sunset, sunsetAngleTime(opt, sunPosition))
 	}
 
 	return periodicSalatTimes, nil
@@ -180,11 +279,7 @@ func (s *Schedule) Maghrib(opt option.Option) (model.PeriodicSalatTime, error) {
 
 	periodicSalatTimes := make(model.PeriodicSalatTime, len(opt.GetSunPositions()))
 	for i, sunPosition := range opt.GetSunPositions() {
-		periodicSalatTimes[i] = model.SalatTime{
-			Date:  sunPosition.Date,
-			Salat: salatEnum.Maghrib,
-			Time:  opt.RoundTime(maghribAngleTime(opt, sunPosition).ToTime()),
-		}
+		periodicSalatTimes[i] = newSalatTime(opt, sunPosition.Date, salatEnum.Maghrib, maghribAngleTime(opt, sunPosition))
 	}
 
 	return periodicSalatTimes, nil
@@ -202,94 +297,145 @@ func (s *Schedule) Isha(opt option.Option) (model.PeriodicSalatTime, error) {
 
 	periodicSalatTimes := make(model.PeriodicSalatTime, len(opt.GetSunPositions()))
 	for i, sunPosition := range opt.GetSunPositions() {
-		ishaHighAlt, ishaType := opt.CalculateIshaHighAltitude(sunPosition.Declination)
+		periodicSalatTimes[i] = newSalatTime(opt, sunPosition.Date, salatEnum.Isha, ishaAngleTime(opt, sunPosition))
+	}
 
-		angTime := angle.Angle{}
-		if ishaType == sunZenithEnum.Standard {
-			angTime = sunPosition.SunTransitTime.Add(ishaHighAlt)
-		}
+	return periodicSalatTimes, nil
+}
 
-		if ishaType == sunZenithEnum.AfterMagrib {
-			angTime = maghribAngleTime(opt, sunPosition).Add(ishaHighAlt)
+// AllTimes returns the salat times of the date range without the excluded dates and with the special handling of the special dates applied
+func (s *Schedule) AllTimes(opt option.Option) (model.PeriodicAllSalatTime, error) {
+	periodicAllSalatTimes, err := s.allTimes(opt)
+	if err != nil {
+		return model.PeriodicAllSalatTime{}, err
+	}
+
+	handledAllSalatTimes := make(model.PeriodicAllSalatTime, 0, len(periodicAllSalatTimes))
+	for _, allSalatTime := range periodicAllSalatTimes {
+		if opt.IsExcludedDate(allSalatTime.Date) {
+			continue
 		}
 
-		periodicSalatTimes[i] = model.SalatTime{
-			Date:  sunPosition.Date,
-			Salat: salatEnum.Isha,
-			Time:  opt.RoundTime(angTime.ToTime()),
+		if special, ok := opt.GetSpecialHandling(allSalatTime.Date); ok {
+			allSalatTime = allSalatTime.WithSpecialHandling(special)
 		}
+
+		handledAllSalatTimes = append(handledAllSalatTimes, allSalatTime)
 	}
 
-	return periodicSalatTimes, nil
+	return handledAllSalatTimes, nil
 }
 
-func (s *Schedule) AllTimes(opt option.Option) (model.PeriodicAllSalatTime, error) {
+// allTimes returns the salat times of every date of the range, one for each sun position
+func (s *Schedule) allTimes(opt option.Option) (model.PeriodicAllSalatTime, error) {
 	if err := opt.ValidateBySalat(0); err != nil {
 		return model.PeriodicAllSalatTime{}, err
 	}
 
+	opt, err := opt.CalculateSunPositions()
+	if err != nil {
+		return model.PeriodicAllSalatTime{}, err
+	}
+
+	salatTimesFuncs := map[salatEnum.Salat]func(opt option.Option) (model.PeriodicSalatTime, error){
+		salatEnum.Midnight: s.Midnight,
+		salatEnum.Fajr:     s.Fajr,
+		salatEnum.Sunrise:  s.Sunrise,
+		salatEnum.Dhuhr:    s.Dhuhr,
+		salatEnum.Asr:      s.Asr,
+		salatEnum.Sunset:   s.Sunset,
+		salatEnum.Maghrib:  s.Maghrib,
+		salatEnum.Isha:     s.Isha,
+	}
+
+	salats := opt.GetSalats()
+
 	periodicAllSalatTimes := make(model.PeriodicAllSalatTime, len(opt.GetSunPositions()))
 	for i, sunPosition := range opt.GetSunPositions() {
-		dateOpt := opt
-		dateOpt, err := dateOpt.SetDateRange(sunPosition.Date, sunPosition.Date).CalculateSunPositions()
+		dateOpt, err := opt.Clone().SetDateRange(sunPosition.Date, sunPosition.Date).CalculateSunPositions()
 		if err != nil {
 			return model.PeriodicAllSalatTime{}, err
 		}
 
-		midnight, err := s.Midnight(dateOpt)
-		if err != nil {
-			return model.PeriodicAllSalatTime{}, err
-		}
+		salatTimes := make(model.PeriodicSalatTime, len(salats))
+		for j, salat := range salats {
+			salatTime, err := salatTimesFuncs[salat](dateOpt)
+			if err != nil {
+				return model.PeriodicAllSalatTime{}, err
+			}
 
-		fajr, err := s.Fajr(dateOpt)
-		if err != nil {
-			return model.PeriodicAllSalatTime{}, err
+			salatTimes[j] = salatTime[0]
 		}
 
-		sunrise, err := s.Sunrise(dateOpt)
-		if err != nil {
-			return model.PeriodicAllSalatTime{}, err
+		periodicAllSalatTimes[i] = model.AllSalatTime{
+			Date:       sunPosition.Date,
+			SalatTimes: salatTimes,
 		}
+	}
 
-		dhuhr, err := s.Dhuhr(dateOpt)
-		if err != nil {
-			return model.PeriodicAllSalatTime{}, err
-		}
+	return periodicAllSalatTimes, nil
+}
 
-		asr, err := s.Asr(dateOpt)
-		if err != nil {
-			return model.PeriodicAllSalatTime{}, err
-		}
+// AtSeaLevel returns the salat times with zero elevation on the same sun positions, to be compared with the elevation adjusted AllTimes
+func (s *Schedule) AtSeaLevel(opt option.Option) (model.PeriodicAllSalatTime, error) {
+	if err := opt.ValidateBySalat(0); err != nil {
+		return model.PeriodicAllSalatTime{}, err
+	}
 
-		sunset, err := s.Sunset(dateOpt)
-		if err != nil {
-			return model.PeriodicAllSalatTime{}, err
-		}
+	opt, err := opt.CalculateSunPositions()
+	if err != nil {
+		return model.PeriodicAllSalatTime{}, err
+	}
+
+	return s.AllTimes(opt.Clone().SetElevation(0.))
+}
 
-		maghrib, err := s.Maghrib(dateOpt)
+// fivePrayers are the obligatory salats counted as the prayers, leaving out the midnight, sunrise, and sunset
+var fivePrayers = []salatEnum.Salat{
+	salatEnum.Fajr,
+	salatEnum.Dhuhr,
+	salatEnum.Asr,
+	salatEnum.Maghrib,
+	salatEnum.Isha,
+}
+
+// NextPrayers returns the next n of the five prayers after now, rolling into the following days as needed.
+// The special handling of the special dates overrides the prayer times, e.g. the jumu'ah time in place of the dhuhr.
+func (s *Schedule) NextPrayers(opt option.Option, now time.Time, n int) (model.PeriodicSalatTime, error) {
+	if err := opt.ValidateBySalat(0); err != nil {
+		return model.PeriodicSalatTime{}, err
+	}
+
+	nextPrayers := model.PeriodicSalatTime{}
+	if n <= 0 {
+		return nextPrayers, nil
+	}
+
+	// the isha of the previous day may still be ahead of now at higher latitudes
+	date := now.In(opt.GetTimezone()).AddDate(0, 0, -1)
+	for len(nextPrayers) < n {
+		allSalatTimes, err := s.allTimes(opt.Clone().SetDateRange(date, date).SetSalats(fivePrayers...))
 		if err != nil {
-			return model.PeriodicAllSalatTime{}, err
+			return model.PeriodicSalatTime{}, err
 		}
 
-		isha, err := s.Isha(dateOpt)
-		if err != nil {
-			return model.PeriodicAllSalatTime{}, err
+		allSalatTime := allSalatTimes[0]
+		if special, ok := opt.GetSpecialHandling(allSalatTime.Date); ok {
+			allSalatTime = allSalatTime.WithSpecialHandling(special)
 		}
 
-		periodicAllSalatTimes[i] = model.AllSalatTime{
-			Date: sunPosition.Date,
-			SalatTimes: []model.SalatTime{
-				midnight[0],
-				fajr[0],
-				sunrise[0],
-				dhuhr[0],
-				asr[0],
-				sunset[0],
-				maghrib[0],
-				isha[0],
-			},
+		for _, salatTime := range allSalatTime.SalatTimes {
+			if !salatTime.Unavailable && salatTime.Time.After(now) {
+				nextPrayers = append(nextPrayers, salatTime)
+			}
 		}
+
+		date = date.AddDate(0, 0, 1)
 	}
 
-	return periodicAllSalatTimes, nil
+	sort.SliceStable(nextPrayers, func(i, j int) bool {
+		return nextPrayers[i].Time.Before(nextPrayers[j].Time)
+	})
+
+	return nextPrayers[:n], nil
 }
@@ -0,0 +1,124 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/naufalfmm/angle"
+	mazhabEnum "github.com/naufalfmm/moslem-salat-times/enum/mazhab"
+	roundingTimeOptionEnum "github.com/naufalfmm/moslem-salat-times/enum/roundingTimeOption"
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	twilightEnum "github.com/naufalfmm/moslem-salat-times/enum/twilight"
+	"github.com/naufalfmm/moslem-salat-times/model"
+	"github.com/naufalfmm/moslem-salat-times/option"
+)
+
+func newTestOption(latitude, longitude float64, loc *time.Location, date time.Time) option.Option {
+	return (&Option{}).
+		SetLatitudeLongitude(angle.NewDegreeFromFloat(latitude), angle.NewDegreeFromFloat(longitude)).
+		SetTimezone(loc).
+		SetTwilightConvention(twilightEnum.Astronomical, twilightEnum.Astronomical).
+		SetMazhab(mazhabEnum.Standard).
+		SetDateRange(date, date)
+}
+
+func TestAllTimesRawAndRoundedTime(t *testing.T) {
+	jakarta := time.FixedZone("0700", 7*60*60)
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, jakarta)
+
+	tests := []struct {
+		name     string
+		rounding roundingTimeOptionEnum.RoundingTimeOption
+		check    func(rawTime, roundedTime time.Time) bool
+	}{
+		{"no rounding", roundingTimeOptionEnum.NoRounding, func(rawTime, roundedTime time.Time) bool {
+			return roundedTime.Equal(rawTime)
+		}},
+		{"minute ceil", roundingTimeOptionEnum.MinuteCeil, func(rawTime, roundedTime time.Time) bool {
+			return roundedTime.Second() == 0 && !roundedTime.Before(rawTime) && roundedTime.Sub(rawTime) < time.Minute
+		}},
+		{"minute floor", roundingTimeOptionEnum.MinuteFloor, func(rawTime, roundedTime time.Time) bool {
+			return roundedTime.Equal(rawTime.Truncate(time.Minute))
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opt := newTestOption(-6.2, 106.8167, jakarta, date).SetRoundingTimeOption(tt.rounding)
+
+			allSalatTimes, err := (&Schedule{}).AllTimes(opt)
+			if err != nil {
+				t.Fatalf("AllTimes() error = %v", err)
+			}
+
+			unroundedCount := 0
+			for _, salatTime := range allSalatTimes[0].SalatTimes {
+				if salatTime.RawTime.Truncate(time.Minute) != salatTime.RawTime {
+					unroundedCount++
+				}
+
+				if !tt.check(salatTime.RawTime, salatTime.Time) {
+					t.Errorf("%s: time %v does not follow the rounding of the raw time %v", salatTime.Salat.Code(), salatTime.Time, salatTime.RawTime)
+				}
+			}
+
+			if unroundedCount == 0 {
+				t.Errorf("every raw time is on a whole minute, want the unrounded times")
+			}
+		})
+	}
+}
+
+func TestSalatTimeRoundingDefault(t *testing.T) {
+	jakarta := time.FixedZone("0700", 7*60*60)
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, jakarta)
+
+	dhuhrs, err := (&Schedule{}).Dhuhr(newTestOption(-6.2, 106.8167, jakarta, date))
+	if err != nil {
+		t.Fatalf("Dhuhr() error = %v", err)
+	}
+
+	if dhuhrs[0].Salat != salatEnum.Dhuhr || !dhuhrs[0].Time.Equal(dhuhrs[0].RawTime) {
+		t.Errorf("dhuhr = %+v, want the raw time without the rounding option", dhuhrs[0])
+	}
+}
+
+func TestNextPrayers(t *testing.T) {
+	jakarta := time.FixedZone("0700", 7*60*60)
+	friday := time.Date(2024, time.March, 1, 0, 0, 0, 0, jakarta)
+	jumuah := time.Date(2024, time.March, 1, 12, 30, 0, 0, jakarta)
+
+	opt := newTestOption(-6.2, 106.8167, jakarta, friday).
+		SetSpecialDates(map[time.Time]model.SpecialHandling{
+			friday: {Label: "jumu'ah", Overrides: map[salatEnum.Salat]time.Time{salatEnum.Dhuhr: jumuah}},
+		})
+
+	maghribs, err := (&Schedule{}).Maghrib(opt.Clone().SetDateRange(friday.AddDate(0, 0, -1), friday.AddDate(0, 0, -1)))
+	if err != nil {
+		t.Fatalf("Maghrib() error = %v", err)
+	}
+
+	nextPrayers, err := (&Schedule{}).NextPrayers(opt, maghribs[0].Time.Add(-time.Minute), 7)
+	if err != nil {
+		t.Fatalf("NextPrayers() error = %v", err)
+	}
+
+	wantSalats := []salatEnum.Salat{salatEnum.Maghrib, salatEnum.Isha, salatEnum.Fajr, salatEnum.Dhuhr, salatEnum.Asr, salatEnum.Maghrib, salatEnum.Isha}
+	if len(nextPrayers) != len(wantSalats) {
+		t.Fatalf("NextPrayers() returned %d prayers, want %d", len(nextPrayers), len(wantSalats))
+	}
+
+	for i, salatTime := range nextPrayers {
+		if salatTime.Salat != wantSalats[i] {
+			t.Errorf("prayer %d = %s, want %s", i, salatTime.Salat.Code(), wantSalats[i].Code())
+		}
+
+		if i > 0 && salatTime.Time.Before(nextPrayers[i-1].Time) {
+			t.Errorf("prayer %d at %v comes before the previous one at %v", i, salatTime.Time, nextPrayers[i-1].Time)
+		}
+	}
+
+	if !nextPrayers[3].Time.Equal(jumuah) {
+		t.Errorf("friday dhuhr = %v, want the jumu'ah override %v", nextPrayers[3].Time, jumuah)
+	}
+}
@@ -0,0 +1,46 @@
+package schedule
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/naufalfmm/moslem-salat-times/err"
+	"github.com/naufalfmm/moslem-salat-times/option"
+)
+
+const selfTestDateFormat = "2006-01-02"
+
+// SelfTest computes the selected salats on every day of the year and reports the dates on which any of them is undefined,
+// e.g. the fajr and isha of the high latitude summer, wrapping err.ErrSalatUndefined
+func (s *Schedule) SelfTest(opt option.Option, year int) error {
+	for _, salat := range opt.GetSalats() {
+		if err := opt.ValidateBySalat(salat); err != nil {
+			return err
+		}
+	}
+
+	yearOpt, calcErr := yearOption(opt, year)
+	if calcErr != nil {
+		return calcErr
+	}
+
+	var failures []string
+	for _, sunPosition := range yearOpt.GetSunPositions() {
+		var undefinedSalats []string
+		for _, salat := range yearOpt.GetSalats() {
+			if isSalatUndefined(yearOpt, salat, sunPosition) {
+				undefinedSalats = append(undefinedSalats, salat.Code())
+			}
+		}
+
+		if len(undefinedSalats) > 0 {
+			failures = append(failures, fmt.Sprintf("%s (%s)", sunPosition.Date.Format(selfTestDateFormat), strings.Join(undefinedSalats, ", ")))
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("%w on %d dates: %s", err.ErrSalatUndefined, len(failures), strings.Join(failures, "; "))
+	}
+
+	return nil
+}
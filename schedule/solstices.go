@@ -0,0 +1,47 @@
+package schedule
+
+import (
+	"math"
+	"time"
+
+	accuracyModeEnum "github.com/naufalfmm/moslem-salat-times/enum/accuracyMode"
+	"github.com/naufalfmm/moslem-salat-times/option"
+	"github.com/naufalfmm/moslem-salat-times/utils/sunPositions"
+)
+
+// seasonCrossing returns the instant on which the ecliptic longitude of the sun reaches the target degree,
+// interpolated between the daily sun positions. The zero time is returned when it is not reached.
+func seasonCrossing(sunPoss sunPositions.SunPositions, target float64) time.Time {
+	for i := 0; i+1 < len(sunPoss); i++ {
+		eclipticLong := sunPoss[i].EclipticLong.ToDegree().ToFloat()
+
+		dailyMotion := math.Mod(sunPoss[i+1].EclipticLong.ToDegree().ToFloat()-eclipticLong+360., 360.)
+		toTarget := math.Mod(target-eclipticLong+360., 360.)
+		if toTarget >= dailyMotion {
+			continue
+		}
+
+		interval := sunPoss[i+1].Date.Sub(sunPoss[i].Date)
+		return sunPoss[i].Date.Add(time.Duration(float64(interval) * toTarget / dailyMotion))
+	}
+
+	return time.Time{}
+}
+
+// Solstices returns the equinoxes and the solstices of the year in the option timezone, that are when the declination crosses zero
+// or reaches its extremes. They are when the ecliptic longitude of the sun is 0°, 90°, 180°, and 270°.
+// The sun positions are always computed, the solar override and the ephemeris of the option having no ecliptic longitude.
+func (s *Schedule) Solstices(opt option.Option, year int) (time.Time, time.Time, time.Time, time.Time, error) {
+	if err := opt.ValidateBySalat(0); err != nil {
+		return time.Time{}, time.Time{}, time.Time{}, time.Time{}, err
+	}
+
+	yearOpt, err := yearOption(opt.Clone().SetAccuracyMode(accuracyModeEnum.Precise).ClearSolarOverride().ClearEphemeris(), year)
+	if err != nil {
+		return time.Time{}, time.Time{}, time.Time{}, time.Time{}, err
+	}
+
+	sunPoss := yearOpt.GetSunPositions()
+
+	return seasonCrossing(sunPoss, 0.), seasonCrossing(sunPoss, 90.), seasonCrossing(sunPoss, 180.), seasonCrossing(sunPoss, 270.), nil
+}
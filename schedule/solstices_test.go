@@ -0,0 +1,51 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/naufalfmm/angle"
+	"github.com/naufalfmm/moslem-salat-times/option"
+)
+
+func TestSolstices(t *testing.T) {
+	base := newTestOption(-6.2, 106.8167, time.UTC, time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name string
+		opt  option.Option
+	}{
+		{"computed", base},
+		{"solar override", base.Clone().SetSolarOverride(angle.NewDegreeFromFloat(10.), 5*time.Minute)},
+		{"ephemeris", base.Clone().SetEphemeris(func(date time.Time) (angle.Angle, time.Duration, error) {
+			return angle.NewDegreeFromFloat(10.), 5 * time.Minute, nil
+		})},
+	}
+
+	wants := []time.Time{
+		time.Date(2024, time.March, 20, 3, 6, 0, 0, time.UTC),
+		time.Date(2024, time.June, 20, 20, 51, 0, 0, time.UTC),
+		time.Date(2024, time.September, 22, 12, 44, 0, 0, time.UTC),
+		time.Date(2024, time.December, 21, 9, 20, 0, 0, time.UTC),
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			marchEquinox, juneSolstice, septemberEquinox, decemberSolstice, err := (&Schedule{}).Solstices(tt.opt, 2024)
+			if err != nil {
+				t.Fatalf("Solstices() error = %v", err)
+			}
+
+			for i, got := range []time.Time{marchEquinox, juneSolstice, septemberEquinox, decemberSolstice} {
+				diff := got.Sub(wants[i])
+				if diff < 0 {
+					diff = -diff
+				}
+
+				if diff > time.Hour {
+					t.Errorf("Solstices()[%d] = %v, want %v within an hour", i, got, wants[i])
+				}
+			}
+		})
+	}
+}
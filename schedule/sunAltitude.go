@@ -0,0 +1,116 @@
+package schedule
+
+import (
+	"math"
+	"time"
+
+	"github.com/naufalfmm/angle"
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	"github.com/naufalfmm/moslem-salat-times/err"
+	"github.com/naufalfmm/moslem-salat-times/model"
+	"github.com/naufalfmm/moslem-salat-times/option"
+)
+
+func sunAltitudeOfSalat(opt option.Option, salat salatEnum.Salat, date time.Time) (angle.Angle, bool, error) {
+	dateOpt, err := opt.Clone().SetDateRange(date, date).CalculateSunPositions()
+	if err != nil {
+		return angle.Angle{}, false, err
+	}
+
+	sunPosition := dateOpt.GetSunPositions()[0]
+
+	angTime, ok := salatAngleTime(dateOpt, salat, sunPosition)
+	if !ok {
+		return angle.Angle{}, false, nil
+	}
+
+	hourAngle := angTime.Sub(sunPosition.SunTransitTime).Mul(15.)
+
+	return dateOpt.CalculateSunAltitude(sunPosition.Declination, hourAngle), true, nil
+}
+
+// SunAltitudeAt returns the sun altitude at the computed (unrounded) salat time of the date
+func (s *Schedule) SunAltitudeAt(opt option.Option, salat salatEnum.Salat, date time.Time) (angle.Angle, error) {
+	if err := opt.ValidateBySalat(salat); err != nil {
+		return angle.Angle{}, err
+	}
+
+	sunAltitude, ok, calcErr := sunAltitudeOfSalat(opt, salat, date)
+	if calcErr != nil {
+		return angle.Angle{}, calcErr
+	}
+
+	if !ok {
+		return angle.Angle{}, err.ErrSalatNotSupported
+	}
+
+	return sunAltitude, nil
+}
+
+// signedDegree normalizes the degree into [-180°, 180°)
+func signedDegree(deg float64) float64 {
+	deg = math.Mod(deg+180., 360.)
+	if deg < 0 {
+		deg += 360.
+	}
+
+	return deg - 180.
+}
+
+// sunHourAngle returns the local hour angle of the sun within [-180°, 180°), negative before and positive after the transit
+func sunHourAngle(opt option.Option, t time.Time) (angle.Angle, error) {
+	date := t.In(opt.GetTimezone())
+
+	dateOpt, err := opt.Clone().SetDateRange(date, date).CalculateSunPositions()
+	if err != nil {
+		return angle.Angle{}, err
+	}
+
+	sunPosition := dateOpt.GetSunPositions()[0]
+	transit := angleTimeOnDate(sunPosition.SunTransitTime, sunPosition.Date)
+
+	return angle.NewDegreeFromFloat(signedDegree(t.Sub(transit).Hours() * 15.)), nil
+}
+
+func (s *Schedule) SunHourAngle(opt option.Option, t time.Time) (angle.Angle, error) {
+	if err := opt.ValidateBySalat(0); err != nil {
+		return angle.Angle{}, err
+	}
+
+	return sunHourAngle(opt, t)
+}
+
+// SolarAltitudeSeries samples the sun altitude by the step from the local midnight of the date until the next midnight
+func (s *Schedule) SolarAltitudeSeries(opt option.Option, date time.Time, step time.Duration) (model.SolarAltitudeSeries, error) {
+	if err := opt.ValidateBySalat(0); err != nil {
+		return nil, err
+	}
+
+	if step <= 0 {
+		return nil, err.ErrInvalidStep
+	}
+
+	dateOpt, calcErr := opt.Clone().SetDateRange(date, date).CalculateSunPositions()
+	if calcErr != nil {
+		return nil, calcErr
+	}
+
+	sunPosition := dateOpt.GetSunPositions()[0]
+	transit := angleTimeOnDate(sunPosition.SunTransitTime, sunPosition.Date)
+
+	year, month, day := sunPosition.Date.Date()
+	start := time.Date(year, month, day, 0, 0, 0, 0, sunPosition.Date.Location())
+	end := time.Date(year, month, day+1, 0, 0, 0, 0, sunPosition.Date.Location())
+
+	series := model.SolarAltitudeSeries{}
+	for t := start; t.Before(end); t = t.Add(step) {
+		hourAngle := angle.NewDegreeFromFloat(t.Sub(transit).Hours() * 15.)
+
+		series = append(series, model.SolarAltitude{
+			Time:     t,
+			Altitude: dateOpt.CalculateSunAltitude(sunPosition.Declination, hourAngle),
+		})
+	}
+
+	return series, nil
+}
@@ -0,0 +1,62 @@
+package schedule
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/naufalfmm/angle"
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	"github.com/naufalfmm/moslem-salat-times/err"
+)
+
+func TestSunAltitudeAt(t *testing.T) {
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		latitude float64
+		salat    salatEnum.Salat
+		want     func(declination float64) float64
+	}{
+		{"fajr", -6.2, salatEnum.Fajr, func(float64) float64 { return -17. }},
+		{"isha", -6.2, salatEnum.Isha, func(float64) float64 { return -16. }},
+		{"sunrise", -6.2, salatEnum.Sunrise, func(float64) float64 { return -0.833 }},
+		{"sunset", 51.5, salatEnum.Sunset, func(float64) float64 { return -0.833 }},
+		{"fajr at 51.5°N", 51.5, salatEnum.Fajr, func(float64) float64 { return -17. }},
+		{"asr", -6.2, salatEnum.Asr, func(declination float64) float64 {
+			return math.Atan(1./(1.+math.Tan(math.Abs(-6.2-declination)*math.Pi/180.))) * 180. / math.Pi
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opt := newTestOption(tt.latitude, 106.8167, time.UTC, date).
+				SetFajrIshaZenith(angle.NewDegreeFromFloat(17.), angle.NewDegreeFromFloat(16.))
+
+			altitude, calcErr := (&Schedule{}).SunAltitudeAt(opt, tt.salat, date)
+			if calcErr != nil {
+				t.Fatalf("SunAltitudeAt() error = %v", calcErr)
+			}
+
+			dateOpt, calcErr := opt.Clone().CalculateSunPositions()
+			if calcErr != nil {
+				t.Fatalf("CalculateSunPositions() error = %v", calcErr)
+			}
+
+			want := tt.want(dateOpt.GetSunPositions()[0].Declination.ToDegree().ToFloat())
+			if got := altitude.ToDegree().ToFloat(); math.Abs(got-want) > 1e-6 {
+				t.Errorf("SunAltitudeAt() = %v°, want %v°", got, want)
+			}
+		})
+	}
+}
+
+func TestSunAltitudeAtMidnight(t *testing.T) {
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	if _, calcErr := (&Schedule{}).SunAltitudeAt(newTestOption(-6.2, 106.8167, time.UTC, date), salatEnum.Midnight, date); !errors.Is(calcErr, err.ErrSalatNotSupported) {
+		t.Errorf("SunAltitudeAt() of the midnight error = %v, want %v", calcErr, err.ErrSalatNotSupported)
+	}
+}
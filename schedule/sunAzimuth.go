@@ -0,0 +1,69 @@
+package schedule
+
+import (
+	"math"
+	"time"
+
+	"github.com/naufalfmm/angle"
+	"github.com/naufalfmm/moslem-salat-times/option"
+	"github.com/naufalfmm/moslem-salat-times/utils/sunPositions"
+)
+
+const (
+	azimuthScanStep  = 10 * time.Minute
+	azimuthPrecision = time.Second
+)
+
+// azimuthDiff returns the signed difference between the sun azimuth at t and the target degree
+func azimuthDiff(opt option.Option, sunPos sunPositions.SunPosition, transit, t time.Time, target float64) float64 {
+	hourAngle := angle.NewDegreeFromFloat(t.Sub(transit).Hours() * 15.)
+
+	return signedDegree(opt.CalculateSunAzimuth(sunPos.Declination, hourAngle).ToDegree().ToFloat() - target)
+}
+
+// TimeAtAzimuth returns the instants of the date on which the sun is above the horizon at the azimuth, clockwise from the true north.
+// The day is scanned every 10 minutes and each crossing is bisected to the second.
+func (s *Schedule) TimeAtAzimuth(opt option.Option, date time.Time, azimuth angle.Angle) ([]time.Time, error) {
+	if err := opt.ValidateBySalat(0); err != nil {
+		return nil, err
+	}
+
+	dateOpt, err := opt.Clone().SetDateRange(date, date).CalculateSunPositions()
+	if err != nil {
+		return nil, err
+	}
+
+	sunPosition := dateOpt.GetSunPositions()[0]
+	transit := angleTimeOnDate(sunPosition.SunTransitTime, sunPosition.Date)
+	target := azimuth.ToDegree().ToFloat()
+
+	dayStart := time.Date(sunPosition.Date.Year(), sunPosition.Date.Month(), sunPosition.Date.Day(), 0, 0, 0, 0, sunPosition.Date.Location())
+	dayEnd := dayStart.AddDate(0, 0, 1)
+
+	times := []time.Time{}
+	for scan := dayStart; scan.Before(dayEnd); scan = scan.Add(azimuthScanStep) {
+		low, high := scan, scan.Add(azimuthScanStep)
+		lowDiff, highDiff := azimuthDiff(dateOpt, sunPosition, transit, low, target), azimuthDiff(dateOpt, sunPosition, transit, high, target)
+
+		// the azimuth wraps through the opposite direction when the differences jump by a half rotation
+		if lowDiff*highDiff > 0 || highDiff == 0 || math.Abs(lowDiff-highDiff) >= 180. {
+			continue
+		}
+
+		for high.Sub(low) > azimuthPrecision {
+			mid := low.Add(high.Sub(low) / 2)
+			if midDiff := azimuthDiff(dateOpt, sunPosition, transit, mid, target); lowDiff*midDiff <= 0 {
+				high = mid
+			} else {
+				low, lowDiff = mid, midDiff
+			}
+		}
+
+		hourAngle := angle.NewDegreeFromFloat(low.Sub(transit).Hours() * 15.)
+		if dateOpt.CalculateSunAltitude(sunPosition.Declination, hourAngle).ToDegree().ToFloat() > 0 {
+			times = append(times, low.Round(azimuthPrecision))
+		}
+	}
+
+	return times, nil
+}
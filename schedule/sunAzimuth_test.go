@@ -0,0 +1,63 @@
+package schedule
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/naufalfmm/angle"
+)
+
+func TestTimeAtAzimuth(t *testing.T) {
+	date := time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC)
+	opt := newTestOption(45., 0., time.UTC, date)
+
+	dateOpt, calcErr := opt.Clone().CalculateSunPositions()
+	if calcErr != nil {
+		t.Fatalf("CalculateSunPositions() error = %v", calcErr)
+	}
+
+	sunPosition := dateOpt.GetSunPositions()[0]
+	transit := angleTimeOnDate(sunPosition.SunTransitTime, sunPosition.Date)
+
+	tests := []struct {
+		name      string
+		azimuth   float64
+		wantCount int
+		check     func(at time.Time) bool
+	}{
+		{"south at the transit", 180., 1, func(at time.Time) bool {
+			return math.Abs(at.Sub(transit).Seconds()) <= 2
+		}},
+		{"east in the morning", 95., 1, func(at time.Time) bool {
+			return at.Before(transit)
+		}},
+		{"west in the afternoon", 265., 1, func(at time.Time) bool {
+			return at.After(transit)
+		}},
+		{"north below the horizon", 0., 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			times, calcErr := (&Schedule{}).TimeAtAzimuth(opt, date, angle.NewDegreeFromFloat(tt.azimuth))
+			if calcErr != nil {
+				t.Fatalf("TimeAtAzimuth() error = %v", calcErr)
+			}
+
+			if len(times) != tt.wantCount {
+				t.Fatalf("TimeAtAzimuth() = %v, want %d instants", times, tt.wantCount)
+			}
+
+			for _, at := range times {
+				if diff := azimuthDiff(dateOpt, sunPosition, transit, at, tt.azimuth); math.Abs(diff) > 0.01 {
+					t.Errorf("TimeAtAzimuth() = %v whose azimuth is off by %v°", at, diff)
+				}
+
+				if !tt.check(at) {
+					t.Errorf("TimeAtAzimuth() = %v, want around the transit %v", at, transit)
+				}
+			}
+		})
+	}
+}
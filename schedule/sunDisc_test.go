@@ -0,0 +1,48 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/naufalfmm/angle"
+	sunDiscEnum "github.com/naufalfmm/moslem-salat-times/enum/sunDisc"
+)
+
+func TestSunDiscReferenceSunrise(t *testing.T) {
+	date := time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC)
+
+	sunriseOf := func(sunriseSunsetZenith float64, sunDisc sunDiscEnum.SunDisc) time.Time {
+		opt := newTestOption(0., 0., time.UTC, date).
+			SetSunriseSunsetZenith(angle.NewDegreeFromFloat(sunriseSunsetZenith)).
+			SetSunDiscReference(sunDisc)
+
+		sunrises, calcErr := (&Schedule{}).Sunrise(opt)
+		if calcErr != nil {
+			t.Fatalf("Sunrise() error = %v", calcErr)
+		}
+
+		return sunrises[0].RawTime
+	}
+
+	semidiameterTime := time.Duration(16. / 60. / 15. * float64(time.Hour))
+
+	tests := []struct {
+		name                string
+		sunriseSunsetZenith float64
+		sunDisc             sunDiscEnum.SunDisc
+		want                time.Duration
+	}{
+		{"center", 0.833, sunDiscEnum.Center, semidiameterTime},
+		{"lower limb", 0.833, sunDiscEnum.LowerLimb, 2 * semidiameterTime},
+		{"lower limb above horizon", 0.3, sunDiscEnum.LowerLimb, 2 * semidiameterTime},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := sunriseOf(tt.sunriseSunsetZenith, tt.sunDisc).Sub(sunriseOf(tt.sunriseSunsetZenith, sunDiscEnum.UpperLimb))
+			if diff < tt.want-5*time.Second || diff > tt.want+5*time.Second {
+				t.Errorf("sunrise later than the upper limb by %v, want about %v", diff, tt.want)
+			}
+		})
+	}
+}
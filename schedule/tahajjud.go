@@ -0,0 +1,50 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/naufalfmm/moslem-salat-times/consts"
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	"github.com/naufalfmm/moslem-salat-times/err"
+	"github.com/naufalfmm/moslem-salat-times/option"
+)
+
+// TahajjudStart returns the time the fraction of the night beginning on the date has passed, 2/3 for the last third when the fraction is zero.
+// The fraction is within [0, 1).
+// The night runs from the sunset to the next sunrise, or from the maghrib to the next fajr when the tahajjud is set until the fajr.
+func (s *Schedule) TahajjudStart(opt option.Option, fraction float64, date time.Time) (time.Time, error) {
+	if fraction == 0 {
+		fraction = consts.TahajjudNightFraction
+	}
+
+	if fraction < 0 || fraction >= 1 {
+		return time.Time{}, err.ErrInvalidFraction
+	}
+
+	startSalat, endSalat := salatEnum.Sunset, salatEnum.Sunrise
+	if opt.GetTahajjudUntilFajr() {
+		startSalat, endSalat = salatEnum.Maghrib, salatEnum.Fajr
+	}
+
+	if err := opt.ValidateBySalat(endSalat); err != nil {
+		return time.Time{}, err
+	}
+
+	dateOpt, calcErr := opt.Clone().SetDateRange(date, date.AddDate(0, 0, 1)).CalculateSunPositions()
+	if calcErr != nil {
+		return time.Time{}, calcErr
+	}
+
+	sunPoss := dateOpt.GetSunPositions()
+
+	start, _ := salatAngleTime(dateOpt, startSalat, sunPoss[0])
+	end, _ := salatAngleTime(dateOpt, endSalat, sunPoss[1])
+	if isAngleUndefined(start) || isAngleUndefined(end) {
+		return time.Time{}, err.ErrSalatUndefined
+	}
+
+	nightStart := angleTimeOnDate(start, sunPoss[0].Date)
+	night := angleTimeOnDate(end, sunPoss[1].Date).Sub(nightStart)
+
+	return dateOpt.RoundTime(nightStart.Add(time.Duration(fraction * float64(night))).Add(dateOpt.GetGlobalOffset())), nil
+}
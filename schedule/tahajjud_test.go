@@ -0,0 +1,49 @@
+package schedule
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/naufalfmm/moslem-salat-times/err"
+)
+
+func TestTahajjudStartFraction(t *testing.T) {
+	jakarta := time.FixedZone("0700", 7*60*60)
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, jakarta)
+	opt := newTestOption(-6.2, 106.8167, jakarta, date)
+
+	for _, fraction := range []float64{-0.5, 1., 1.5} {
+		if _, calcErr := (&Schedule{}).TahajjudStart(opt, fraction, date); !errors.Is(calcErr, err.ErrInvalidFraction) {
+			t.Errorf("TahajjudStart() with the fraction %v error = %v, want %v", fraction, calcErr, err.ErrInvalidFraction)
+		}
+	}
+
+	lastThird, calcErr := (&Schedule{}).TahajjudStart(opt, 2./3., date)
+	if calcErr != nil {
+		t.Fatalf("TahajjudStart() error = %v", calcErr)
+	}
+
+	if lastThird.Day() != 2 || lastThird.Hour() != 2 {
+		t.Errorf("TahajjudStart() = %v, want around 02:03 of the next day", lastThird)
+	}
+
+	defaultFraction, calcErr := (&Schedule{}).TahajjudStart(opt, 0, date)
+	if calcErr != nil {
+		t.Fatalf("TahajjudStart() with the zero fraction error = %v", calcErr)
+	}
+
+	if !defaultFraction.Equal(lastThird) {
+		t.Errorf("TahajjudStart() with the zero fraction = %v, want the last third %v", defaultFraction, lastThird)
+	}
+
+	half, calcErr := (&Schedule{}).TahajjudStart(opt, 0.5, date)
+	if calcErr != nil {
+		t.Fatalf("TahajjudStart() with the half error = %v", calcErr)
+	}
+
+	// the night of Jakarta is about 12 hours, so the half is a sixth of it, about 2 hours, before the last third
+	if gap := lastThird.Sub(half); gap < 110*time.Minute || gap > 130*time.Minute {
+		t.Errorf("TahajjudStart() with the half = %v, want about 2 hours before the last third %v", half, lastThird)
+	}
+}
@@ -0,0 +1,23 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/naufalfmm/moslem-salat-times/option"
+)
+
+// TodayLine renders the fajr, sunrise, dhuhr, asr, maghrib, and isha of the day of now in the option timezone on one line
+func (s *Schedule) TodayLine(opt option.Option, now time.Time) (string, error) {
+	if err := opt.ValidateBySalat(0); err != nil {
+		return "", err
+	}
+
+	date := now.In(opt.GetTimezone())
+
+	allSalatTimes, err := s.allTimes(opt.Clone().SetDateRange(date, date).SetSalats(progressSalats...))
+	if err != nil {
+		return "", err
+	}
+
+	return allSalatTimes[0].ToLine(), nil
+}
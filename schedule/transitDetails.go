@@ -0,0 +1,32 @@
+package schedule
+
+import (
+	"time"
+
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	"github.com/naufalfmm/moslem-salat-times/option"
+)
+
+// TransitDetails breaks the solar transit of the date down, the dhuhr being the transit plus its slight margin.
+// The transit on UTC is 12:00 minus the longitude correction minus the equation of time, both positive when they make the transit earlier.
+// The longitude correction is the east positive longitude at 15° per hour.
+// The local transit is the UTC one plus the timezone offset of the date.
+func (s *Schedule) TransitDetails(opt option.Option, date time.Time) (time.Duration, time.Duration, time.Duration, error) {
+	if err := opt.ValidateBySalat(salatEnum.Dhuhr); err != nil {
+		return 0, 0, 0, err
+	}
+
+	dateOpt, err := opt.Clone().SetDateRange(date, date).CalculateSunPositions()
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	sunPosition := dateOpt.GetSunPositions()[0]
+	_, offset := sunPosition.Date.Zone()
+
+	solarTransitUTC := time.Duration(sunPosition.SunTransitTime.ToDegree().ToFloat()*float64(time.Hour)) - time.Duration(offset)*time.Second
+	equationOfTime := time.Duration(signedDegree(sunPosition.EquationOfTime.ToDegree().ToFloat()) / 15. * float64(time.Hour))
+	longitudeCorrection := time.Duration(signedDegree(dateOpt.GetLongitude().ToDegree().ToFloat()) / 15. * float64(time.Hour))
+
+	return solarTransitUTC, equationOfTime, longitudeCorrection, nil
+}
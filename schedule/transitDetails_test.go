@@ -0,0 +1,41 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTransitDetails(t *testing.T) {
+	date := time.Date(2024, time.November, 3, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                string
+		longitude           float64
+		longitudeCorrection time.Duration
+	}{
+		{"jakarta", 106.8167, 7*time.Hour + 7*time.Minute + 16*time.Second},
+		{"greenwich", 0., 0},
+		{"new york", -74.006, -(4*time.Hour + 56*time.Minute + 1*time.Second)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			solarTransitUTC, equationOfTime, longitudeCorrection, err := (&Schedule{}).TransitDetails(newTestOption(0., tt.longitude, time.UTC, date), date)
+			if err != nil {
+				t.Fatalf("TransitDetails() error = %v", err)
+			}
+
+			if diff := longitudeCorrection - tt.longitudeCorrection; diff < -time.Second || diff > time.Second {
+				t.Errorf("longitude correction = %v, want %v", longitudeCorrection, tt.longitudeCorrection)
+			}
+
+			if equationOfTime < 16*time.Minute || equationOfTime > 17*time.Minute {
+				t.Errorf("equation of time = %v, want about 16m26s", equationOfTime)
+			}
+
+			if sum := solarTransitUTC + equationOfTime + longitudeCorrection; sum < 12*time.Hour-time.Second || sum > 12*time.Hour+time.Second {
+				t.Errorf("transit %v + equation of time %v + longitude correction %v = %v, want 12h", solarTransitUTC, equationOfTime, longitudeCorrection, sum)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/naufalfmm/angle"
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	twilightEnum "github.com/naufalfmm/moslem-salat-times/enum/twilight"
+	"github.com/naufalfmm/moslem-salat-times/err"
+	"github.com/naufalfmm/moslem-salat-times/option"
+)
+
+// TwilightDuration returns the length of the morning twilight, from the twilight boundary to the sunrise,
+// and of the evening twilight, from the sunset to the twilight boundary, on the date.
+// The boundary is where the sun is depressed by the twilight kind around the transit, regardless of the fajr and isha settings of the option.
+func (s *Schedule) TwilightDuration(opt option.Option, kind twilightEnum.Twilight, date time.Time) (time.Duration, time.Duration, error) {
+	if err := opt.ValidateBySalat(salatEnum.Sunrise); err != nil {
+		return 0, 0, err
+	}
+
+	if kind.Depression() == 0 {
+		return 0, 0, err.ErrUnknownConstant
+	}
+
+	dateOpt, calcErr := opt.Clone().SetDateRange(date, date).CalculateSunPositions()
+	if calcErr != nil {
+		return 0, 0, calcErr
+	}
+
+	sunPosition := dateOpt.GetSunPositions()[0]
+
+	sunrise, sunset := sunriseAngleTime(dateOpt, sunPosition), sunsetAngleTime(dateOpt, sunPosition)
+	if isAngleUndefined(sunrise) {
+		return 0, 0, err.ErrDaylightUndefined
+	}
+
+	twilightHighAlt := dateOpt.CalculateDepressionHighAltitude(angle.NewDegreeFromFloat(kind.Depression()), sunPosition.Declination)
+	if isAngleUndefined(twilightHighAlt) {
+		return 0, 0, err.ErrTwilightUndefined
+	}
+
+	dawn, dusk := sunPosition.SunTransitTime.Sub(twilightHighAlt), sunPosition.SunTransitTime.Add(twilightHighAlt)
+
+	return angleTimeDuration(sunrise.Sub(dawn)), angleTimeDuration(dusk.Sub(sunset)), nil
+}
+
+func angleTimeDuration(angTime angle.Angle) time.Duration {
+	return time.Duration(angTime.ToDegree().ToFloat() * float64(time.Hour))
+}
@@ -0,0 +1,54 @@
+package schedule
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/naufalfmm/angle"
+	higherLatEnum "github.com/naufalfmm/moslem-salat-times/enum/higherLat"
+	twilightEnum "github.com/naufalfmm/moslem-salat-times/enum/twilight"
+	"github.com/naufalfmm/moslem-salat-times/err"
+)
+
+func TestTwilightDuration(t *testing.T) {
+	equinox := time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC)
+
+	equatorMorning, equatorEvening, calcErr := (&Schedule{}).TwilightDuration(newTestOption(0., 0., time.UTC, equinox), twilightEnum.Astronomical, equinox)
+	if calcErr != nil {
+		t.Fatalf("TwilightDuration() at the equator error = %v", calcErr)
+	}
+
+	if equatorMorning < 65*time.Minute || equatorMorning > 80*time.Minute || equatorEvening < 65*time.Minute || equatorEvening > 80*time.Minute {
+		t.Errorf("TwilightDuration() at the equator = %v, %v, want about 72 minutes", equatorMorning, equatorEvening)
+	}
+
+	highMorning, highEvening, calcErr := (&Schedule{}).TwilightDuration(newTestOption(55., 0., time.UTC, equinox), twilightEnum.Astronomical, equinox)
+	if calcErr != nil {
+		t.Fatalf("TwilightDuration() at 55°N error = %v", calcErr)
+	}
+
+	if highMorning < equatorMorning+30*time.Minute || highEvening < equatorEvening+30*time.Minute {
+		t.Errorf("TwilightDuration() at 55°N = %v, %v, want at least 30 minutes longer than at the equator", highMorning, highEvening)
+	}
+
+	clampedOpt := newTestOption(55., 0., time.UTC, equinox).
+		SetMinNightFraction(0.5).
+		SetHigherLatitudeMethod(higherLatEnum.NearestLatitude).
+		SetIshaIgnoresElevation(true).
+		SetElevation(0.)
+	clampedMorning, clampedEvening, calcErr := (&Schedule{}).TwilightDuration(clampedOpt, twilightEnum.Astronomical, equinox)
+	if calcErr != nil {
+		t.Fatalf("TwilightDuration() with the night settings error = %v", calcErr)
+	}
+
+	if clampedMorning != highMorning || clampedEvening != highEvening {
+		t.Errorf("TwilightDuration() with the night settings = %v, %v, want %v, %v", clampedMorning, clampedEvening, highMorning, highEvening)
+	}
+
+	summer := time.Date(2024, time.June, 21, 0, 0, 0, 0, time.UTC)
+	summerOpt := newTestOption(60., 0., time.UTC, summer).SetHigherLatitudeMethod(higherLatEnum.NearestLatitude).SetNearestLatitude(angle.NewDegreeFromFloat(48.))
+	if _, _, calcErr := (&Schedule{}).TwilightDuration(summerOpt, twilightEnum.Astronomical, summer); !errors.Is(calcErr, err.ErrTwilightUndefined) {
+		t.Errorf("TwilightDuration() at 60°N in June error = %v, want %v", calcErr, err.ErrTwilightUndefined)
+	}
+}
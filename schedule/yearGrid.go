@@ -0,0 +1,51 @@
+package schedule
+
+import (
+	"time"
+
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	"github.com/naufalfmm/moslem-salat-times/err"
+	"github.com/naufalfmm/moslem-salat-times/option"
+)
+
+const minutesPerDay = 24 * 60
+
+// YearGrid returns the day-of-year by minute-of-day grid of the year marking the minute of the salat on each day.
+// The row of the day on which the salat is undefined or falls outside the local day is left unmarked. The midnight is not supported.
+func (s *Schedule) YearGrid(opt option.Option, year int, salat salatEnum.Salat) ([][]bool, error) {
+	if err := opt.ValidateBySalat(salat); err != nil {
+		return nil, err
+	}
+
+	yearOpt, calcErr := yearOption(opt, year)
+	if calcErr != nil {
+		return nil, calcErr
+	}
+
+	sunPoss := yearOpt.GetSunPositions()
+	grid := make([][]bool, len(sunPoss))
+	for i, sunPosition := range sunPoss {
+		grid[i] = make([]bool, minutesPerDay)
+
+		angTime, ok := salatAngleTime(yearOpt, salat, sunPosition)
+		if !ok {
+			return nil, err.ErrSalatNotSupported
+		}
+
+		if isAngleUndefined(angTime) {
+			continue
+		}
+
+		date := sunPosition.Date
+		midnight := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+
+		minute := int(yearOpt.RoundTime(angleTimeOnDate(angTime, date)).Sub(midnight) / time.Minute)
+		if minute < 0 || minute >= minutesPerDay {
+			continue
+		}
+
+		grid[i][minute] = true
+	}
+
+	return grid, nil
+}
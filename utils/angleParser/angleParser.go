@@ -0,0 +1,185 @@
+package angleParser
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/naufalfmm/angle"
+	"github.com/naufalfmm/moslem-salat-times/err"
+	"github.com/naufalfmm/moslem-salat-times/utils/coordinate"
+)
+
+// secondPrecision drops the floating noise of the second carried from the fractional minute
+const secondPrecision = 1e9
+
+const (
+	degreeComponent = iota
+	minuteComponent
+	secondComponent
+)
+
+// componentSymbols is ordered so the longer symbols are matched first
+var componentSymbols = []struct {
+	symbol    string
+	component int
+}{
+	{"deg", degreeComponent},
+	{"°", degreeComponent},
+	{"º", degreeComponent},
+	{"d", degreeComponent},
+	{"''", secondComponent},
+	{"'", minuteComponent},
+	{"′", minuteComponent},
+	{"’", minuteComponent},
+	{"m", minuteComponent},
+	{"\"", secondComponent},
+	{"″", secondComponent},
+	{"”", secondComponent},
+	{"s", secondComponent},
+}
+
+func matchComponentSymbol(str string) (int, int, bool) {
+	for _, componentSymbol := range componentSymbols {
+		if strings.HasPrefix(str, componentSymbol.symbol) {
+			return componentSymbol.component, len(componentSymbol.symbol), true
+		}
+	}
+
+	return 0, 0, false
+}
+
+func isNumberRune(r rune) bool {
+	return unicode.IsDigit(r) || r == '.'
+}
+
+// Parse parses the angle in degree from the decimal or degree-minute-second string.
+// The degree could be written by °, º, deg, or d, the minute by ', ′, ’, or m, and the second by ", ″, ”, s, or two apostrophes.
+func Parse(src string) (angle.Angle, error) {
+	str := strings.TrimSpace(src)
+
+	neg := false
+	if strings.HasPrefix(str, "-") || strings.HasPrefix(str, "+") {
+		neg = str[0] == '-'
+		str = strings.TrimSpace(str[1:])
+	}
+
+	if str == "" {
+		return angle.Angle{}, err.ErrInvalidAngleFormat
+	}
+
+	var (
+		components [3]float64
+		filled     [3]bool
+		next       = degreeComponent
+	)
+
+	for str != "" {
+		numLen := strings.IndexFunc(str, func(r rune) bool {
+			return !isNumberRune(r)
+		})
+		if numLen == 0 {
+			return angle.Angle{}, err.ErrInvalidAngleFormat
+		}
+
+		if numLen < 0 {
+			numLen = len(str)
+		}
+
+		value, parseErr := strconv.ParseFloat(str[:numLen], 64)
+		if parseErr != nil {
+			return angle.Angle{}, err.ErrInvalidAngleFormat
+		}
+
+		str = strings.TrimSpace(str[numLen:])
+
+		component, symbolLen, ok := matchComponentSymbol(str)
+		if !ok {
+			if str != "" || next > secondComponent {
+				return angle.Angle{}, err.ErrInvalidAngleFormat
+			}
+
+			component = next
+		}
+
+		if component < next || filled[component] {
+			return angle.Angle{}, err.ErrInvalidAngleFormat
+		}
+
+		components[component] = value
+		filled[component] = true
+		next = component + 1
+
+		str = strings.TrimSpace(str[symbolLen:])
+	}
+
+	if !filled[minuteComponent] && !filled[secondComponent] {
+		if neg {
+			return angle.NewDegreeFromFloat(-components[degreeComponent]), nil
+		}
+
+		return angle.NewDegreeFromFloat(components[degreeComponent]), nil
+	}
+
+	return newFromDegreeMinuteSecond(components[degreeComponent], components[minuteComponent], components[secondComponent], neg), nil
+}
+
+// NewFromDegreeDecimalMinute builds the degree-minute-second angle from the nautical degree and decimal minute, e.g. 6°30.5' into 6°30'30"
+func NewFromDegreeDecimalMinute(degree, decimalMinute float64, neg bool) angle.Angle {
+	return newFromDegreeMinuteSecond(degree, decimalMinute, 0, neg)
+}
+
+// newFromDegreeMinuteSecond carries the fractional minute into the second and keeps the sign even when the leading components are zero
+func newFromDegreeMinuteSecond(degree, minute, second float64, neg bool) angle.Angle {
+	minute, fracMinute := math.Modf(minute)
+	second += math.Round(fracMinute*60.*secondPrecision) / secondPrecision
+
+	if !neg {
+		return angle.NewFromDegreeMinuteSecond(degree, minute, second)
+	}
+
+	if degree != 0 {
+		return angle.NewFromDegreeMinuteSecond(-degree, minute, second)
+	}
+
+	if minute != 0 {
+		return angle.NewFromDegreeMinuteSecond(degree, -minute, second)
+	}
+
+	return angle.NewFromDegreeMinuteSecond(degree, minute, -second)
+}
+
+// ParseDecimalPair parses the comma-separated signed decimal latitude and longitude, as copied from the map, e.g. "-6.2088, 106.8456".
+// The latitude out of [-90°, 90°] or the longitude out of [-180°, 180°] is rejected.
+func ParseDecimalPair(src string) (angle.Angle, angle.Angle, error) {
+	parts := strings.Split(src, ",")
+	if len(parts) != 2 {
+		return angle.Angle{}, angle.Angle{}, err.ErrInvalidAngleFormat
+	}
+
+	latitude, latErr := parseSignedDecimal(parts[0])
+	if latErr != nil {
+		return angle.Angle{}, angle.Angle{}, latErr
+	}
+
+	longitude, longErr := parseSignedDecimal(parts[1])
+	if longErr != nil {
+		return angle.Angle{}, angle.Angle{}, longErr
+	}
+
+	if !coordinate.IsValidLatitude(latitude) || !coordinate.IsValidLongitude(longitude) {
+		return angle.Angle{}, angle.Angle{}, err.ErrInvalidAngleFormat
+	}
+
+	return latitude, longitude, nil
+}
+
+func parseSignedDecimal(src string) (angle.Angle, error) {
+	value, parseErr := strconv.ParseFloat(strings.TrimSpace(src), 64)
+	if parseErr != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return angle.Angle{}, err.ErrInvalidAngleFormat
+	}
+
+	return angle.NewDegreeFromFloat(value), nil
+}
@@ -0,0 +1,140 @@
+package angleParser
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/naufalfmm/angle"
+	"github.com/naufalfmm/moslem-salat-times/err"
+)
+
+const tolerance = 1e-9
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		want    float64
+		wantErr error
+	}{
+		{"ascii deg minute second", "6deg30m15s", 6. + 30./60. + 15./3600., nil},
+		{"typographic primes", "6°30′15″", 6. + 30./60. + 15./3600., nil},
+		{"d and m", "6d30m", 6.5, nil},
+		{"straight quotes", "6°30'15\"", 6. + 30./60. + 15./3600., nil},
+		{"two apostrophes", "6°30'15''", 6. + 30./60. + 15./3600., nil},
+		{"ordinal degree", "6º30’15”", 6. + 30./60. + 15./3600., nil},
+		{"spaced", " 6° 30' 15\" ", 6. + 30./60. + 15./3600., nil},
+		{"negative", "-6°30'", -6.5, nil},
+		{"negative minute only", "-0°30'", -0.5, nil},
+		{"decimal", "106.8456", 106.8456, nil},
+		{"decimal degree", "-6.5°", -6.5, nil},
+		{"unmarked trailing minute", "6°30", 6.5, nil},
+		{"missing symbols", "6 30", 0, err.ErrInvalidAngleFormat},
+		{"negative minute", "0°-30'", 0, err.ErrInvalidAngleFormat},
+		{"minute before degree", "30'6°", 0, err.ErrInvalidAngleFormat},
+		{"repeated degree", "6°7°", 0, err.ErrInvalidAngleFormat},
+		{"empty", "", 0, err.ErrInvalidAngleFormat},
+		{"sign only", "-", 0, err.ErrInvalidAngleFormat},
+		{"unknown symbol", "6x", 0, err.ErrInvalidAngleFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, parseErr := Parse(tt.src)
+			if tt.wantErr != nil {
+				if !errors.Is(parseErr, tt.wantErr) {
+					t.Errorf("Parse(%q) error = %v, want %v", tt.src, parseErr, tt.wantErr)
+				}
+
+				return
+			}
+
+			if parseErr != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.src, parseErr)
+			}
+
+			if deg := got.ToDegree().ToFloat(); math.Abs(deg-tt.want) > tolerance {
+				t.Errorf("Parse(%q) = %v°, want %v°", tt.src, deg, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFromDegreeDecimalMinute(t *testing.T) {
+	tests := []struct {
+		name          string
+		degree        float64
+		decimalMinute float64
+		neg           bool
+		src           string
+		want          angle.Angle
+	}{
+		{"6°30.5'", 6, 30.5, false, "6°30.5'", angle.NewFromDegreeMinuteSecond(6, 30, 30)},
+		{"-6°30.5'", 6, 30.5, true, "-6°30.5'", angle.NewFromDegreeMinuteSecond(-6, 30, 30)},
+		{"0°0.25'", 0, 0.25, false, "0°0.25'", angle.NewFromDegreeMinuteSecond(0, 0, 15)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tt.want.ToDegree().ToFloat()
+
+			if got := NewFromDegreeDecimalMinute(tt.degree, tt.decimalMinute, tt.neg).ToDegree().ToFloat(); math.Abs(got-want) > tolerance {
+				t.Errorf("NewFromDegreeDecimalMinute() = %v°, want %v°", got, want)
+			}
+
+			parsed, parseErr := Parse(tt.src)
+			if parseErr != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.src, parseErr)
+			}
+
+			if got := parsed.ToDegree().ToFloat(); math.Abs(got-want) > tolerance {
+				t.Errorf("Parse(%q) = %v°, want %v°", tt.src, got, want)
+			}
+		})
+	}
+}
+
+func TestParseDecimalPair(t *testing.T) {
+	tests := []struct {
+		name          string
+		src           string
+		wantLatitude  float64
+		wantLongitude float64
+		wantErr       error
+	}{
+		{"map copy", "-6.2088, 106.8456", -6.2088, 106.8456, nil},
+		{"both negative", "-33.8688,-151.2093", -33.8688, -151.2093, nil},
+		{"extra whitespace", "  40.7128 ,\t-74.006  ", 40.7128, -74.006, nil},
+		{"null island", "0, 0", 0, 0, nil},
+		{"poles and antimeridian", "90, -180", 90, -180, nil},
+		{"out of range", "95, 200", 0, 0, err.ErrInvalidAngleFormat},
+		{"latitude out of range", "-90.5, 106.8456", 0, 0, err.ErrInvalidAngleFormat},
+		{"longitude out of range", "-6.2088, 180.5", 0, 0, err.ErrInvalidAngleFormat},
+		{"single value", "-6.2088", 0, 0, err.ErrInvalidAngleFormat},
+		{"three values", "-6.2088, 106.8456, 8", 0, 0, err.ErrInvalidAngleFormat},
+		{"not a number", "-6.2088, east", 0, 0, err.ErrInvalidAngleFormat},
+		{"nan", "NaN, 106.8456", 0, 0, err.ErrInvalidAngleFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			latitude, longitude, parseErr := ParseDecimalPair(tt.src)
+			if tt.wantErr != nil {
+				if !errors.Is(parseErr, tt.wantErr) {
+					t.Errorf("ParseDecimalPair(%q) error = %v, want %v", tt.src, parseErr, tt.wantErr)
+				}
+
+				return
+			}
+
+			if parseErr != nil {
+				t.Fatalf("ParseDecimalPair(%q) error = %v", tt.src, parseErr)
+			}
+
+			if lat, long := latitude.ToDegree().ToFloat(), longitude.ToDegree().ToFloat(); math.Abs(lat-tt.wantLatitude) > tolerance || math.Abs(long-tt.wantLongitude) > tolerance {
+				t.Errorf("ParseDecimalPair(%q) = %v°, %v°, want %v°, %v°", tt.src, lat, long, tt.wantLatitude, tt.wantLongitude)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package angleParser
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/naufalfmm/angle"
+	"github.com/naufalfmm/moslem-salat-times/err"
+)
+
+// ParseFixedWidthCoord parses the fixed width coordinate, that is DDMMH or DDMMSSH for the latitude (H is N or S)
+// and DDDMMH or DDDMMSSH for the longitude (H is E or W). The southern and western coordinates are negative.
+func ParseFixedWidthCoord(src string) (angle.Angle, error) {
+	str := strings.ToUpper(strings.TrimSpace(src))
+	if len(str) < 2 {
+		return angle.Angle{}, err.ErrInvalidAngleFormat
+	}
+
+	digits, hemisphere := str[:len(str)-1], str[len(str)-1]
+
+	degreeLen, maxDegree := 0, 0.
+	switch hemisphere {
+	case 'N', 'S':
+		degreeLen, maxDegree = 2, 90.
+	case 'E', 'W':
+		degreeLen, maxDegree = 3, 180.
+	default:
+		return angle.Angle{}, err.ErrInvalidAngleFormat
+	}
+
+	if len(digits) != degreeLen+2 && len(digits) != degreeLen+4 {
+		return angle.Angle{}, err.ErrInvalidAngleFormat
+	}
+
+	components := [3]float64{}
+	for i, start := 0, 0; start < len(digits); i++ {
+		end := start + 2
+		if i == 0 {
+			end = degreeLen
+		}
+
+		value, parseErr := strconv.ParseUint(digits[start:end], 10, 64)
+		if parseErr != nil {
+			return angle.Angle{}, err.ErrInvalidAngleFormat
+		}
+
+		components[i] = float64(value)
+		start = end
+	}
+
+	degree, minute, second := components[degreeComponent], components[minuteComponent], components[secondComponent]
+	if minute >= 60. || second >= 60. || degree+minute/60.+second/3600. > maxDegree {
+		return angle.Angle{}, err.ErrInvalidAngleFormat
+	}
+
+	return newFromDegreeMinuteSecond(degree, minute, second, hemisphere == 'S' || hemisphere == 'W'), nil
+}
@@ -0,0 +1,57 @@
+package angleParser
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/naufalfmm/moslem-salat-times/err"
+)
+
+func TestParseFixedWidthCoord(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		want    float64
+		wantErr error
+	}{
+		{"south latitude", "0630S", -6.5, nil},
+		{"east longitude", "10649E", 106. + 49./60., nil},
+		{"north latitude with seconds", "513030N", 51. + 30./60. + 30./3600., nil},
+		{"west longitude", "07400W", -74., nil},
+		{"lowercase hemisphere", "0630s", -6.5, nil},
+		{"south minutes only", "0030S", -0.5, nil},
+		{"pole", "9000N", 90., nil},
+		{"bad hemisphere", "0630X", 0, err.ErrInvalidAngleFormat},
+		{"latitude with the longitude length", "10649N", 0, err.ErrInvalidAngleFormat},
+		{"longitude with the latitude length", "0630E", 0, err.ErrInvalidAngleFormat},
+		{"too short", "630S", 0, err.ErrInvalidAngleFormat},
+		{"hemisphere only", "S", 0, err.ErrInvalidAngleFormat},
+		{"minutes of 60", "0660S", 0, err.ErrInvalidAngleFormat},
+		{"seconds of 60", "063060S", 0, err.ErrInvalidAngleFormat},
+		{"beyond the pole", "9001N", 0, err.ErrInvalidAngleFormat},
+		{"beyond the antimeridian", "18030E", 0, err.ErrInvalidAngleFormat},
+		{"signed digits", "+630S", 0, err.ErrInvalidAngleFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, parseErr := ParseFixedWidthCoord(tt.src)
+			if tt.wantErr != nil {
+				if !errors.Is(parseErr, tt.wantErr) {
+					t.Errorf("ParseFixedWidthCoord(%q) error = %v, want %v", tt.src, parseErr, tt.wantErr)
+				}
+
+				return
+			}
+
+			if parseErr != nil {
+				t.Fatalf("ParseFixedWidthCoord(%q) error = %v", tt.src, parseErr)
+			}
+
+			if deg := got.ToDegree().ToFloat(); math.Abs(deg-tt.want) > tolerance {
+				t.Errorf("ParseFixedWidthCoord(%q) = %v°, want %v°", tt.src, deg, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package angleUtil
+
+import (
+	"strconv"
+
+	"github.com/naufalfmm/angle"
+	"github.com/naufalfmm/angle/angleType"
+	"github.com/naufalfmm/angle/consts"
+)
+
+// AppendFormat appends the degree of the angle formatted like its String to b and returns the extended buffer, like time.AppendFormat.
+// The degree minute second angle is appended with the parts carried like SafeString.
+func AppendFormat(b []byte, ang angle.Angle) []byte {
+	if ang.AngleType() != angleType.DegreeMinuteSecond {
+		b = strconv.AppendFloat(b, ang.ToDegree().ToFloat(), 'f', -1, 64)
+		return append(b, string(consts.DegreeSymbolRune)...)
+	}
+
+	neg, degree, minute, second := dmsParts(ang)
+	if neg {
+		b = append(b, string(consts.NegativeSymbolRune)...)
+	}
+
+	b = strconv.AppendFloat(b, degree, 'f', -1, 64)
+	b = append(b, string(consts.DegreeSymbolRune)...)
+	b = strconv.AppendFloat(b, minute, 'f', -1, 64)
+	b = append(b, string(consts.MinuteSymbolRune)...)
+	b = strconv.AppendFloat(b, second, 'f', -1, 64)
+	return append(b, string(consts.SecondSymbolRune)...)
+}
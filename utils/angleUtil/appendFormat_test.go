@@ -0,0 +1,55 @@
+package angleUtil
+
+import (
+	"testing"
+
+	"github.com/naufalfmm/angle"
+)
+
+func TestAppendFormat(t *testing.T) {
+	tests := []struct {
+		name string
+		ang  angle.Angle
+	}{
+		{"decimal", angle.NewDegreeFromFloat(6.5)},
+		{"negative decimal", angle.NewDegreeFromFloat(-6.5)},
+		{"dms", angle.NewFromDegreeMinuteSecond(6., 12., 45.5)},
+		{"negative dms", angle.NewFromDegreeMinuteSecond(-106., 48., 0.)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(AppendFormat([]byte("at "), tt.ang)); got != "at "+tt.ang.String() {
+				t.Errorf("AppendFormat() = %q, want %q", got, "at "+tt.ang.String())
+			}
+		})
+	}
+}
+
+func TestAppendFormatAllocs(t *testing.T) {
+	ang := angle.NewFromDegreeMinuteSecond(6., 12., 45.5)
+	buf := make([]byte, 0, 64)
+
+	if allocs := testing.AllocsPerRun(100, func() { AppendFormat(buf[:0], ang) }); allocs != 0 {
+		t.Errorf("AppendFormat() allocs = %v, want 0", allocs)
+	}
+}
+
+func BenchmarkAppendFormat(b *testing.B) {
+	ang := angle.NewFromDegreeMinuteSecond(6., 12., 45.5)
+	buf := make([]byte, 0, 64)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = AppendFormat(buf[:0], ang)
+	}
+}
+
+func BenchmarkString(b *testing.B) {
+	ang := angle.NewFromDegreeMinuteSecond(6., 12., 45.5)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = ang.String()
+	}
+}
@@ -0,0 +1,20 @@
+package angleUtil
+
+import (
+	"math"
+
+	"github.com/naufalfmm/angle"
+)
+
+// Bisect returns the angle halfway along the shorter arc from a to b within [0°, 360°), e.g. 0° for 350° and 10°.
+// The opposite angles are bisected clockwise from a.
+func Bisect(a, b angle.Angle) angle.Angle {
+	aDeg := normalizedDegree(a)
+
+	arc := math.Mod(normalizedDegree(b)-aDeg+360., 360.)
+	if arc > 180. {
+		arc -= 360.
+	}
+
+	return angle.NewDegreeFromFloat(math.Mod(aDeg+arc/2.+360., 360.))
+}
@@ -0,0 +1,31 @@
+package angleUtil
+
+import (
+	"math"
+	"testing"
+
+	"github.com/naufalfmm/angle"
+)
+
+func TestBisect(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b float64
+		want float64
+	}{
+		{"wrap", 350., 10., 0.},
+		{"wrap reversed", 10., 350., 0.},
+		{"normal", 30., 90., 60.},
+		{"wrap off the north", 300., 40., 350.},
+		{"opposite", 0., 180., 90.},
+		{"negative", -20., 20., 0.},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Bisect(angle.NewDegreeFromFloat(tt.a), angle.NewDegreeFromFloat(tt.b)).ToDegree().ToFloat(); math.Abs(got-tt.want) > tolerance {
+				t.Errorf("Bisect() = %v, want %v°", got, tt.want)
+			}
+		})
+	}
+}
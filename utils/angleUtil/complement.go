@@ -0,0 +1,22 @@
+package angleUtil
+
+import (
+	"math"
+
+	"github.com/naufalfmm/angle"
+)
+
+// normalizedDegree returns the decimal degree of the angle within [0°, 360°)
+func normalizedDegree(ang angle.Angle) float64 {
+	return math.Mod(math.Mod(ang.ToDegree().ToFloat(), 360.)+360., 360.)
+}
+
+// Complement returns 90° minus the angle normalized into [0°, 360°) in the angle type of the angle, e.g. the altitude of the zenith
+func Complement(ang angle.Angle) angle.Angle {
+	return angle.NewDegreeFromFloat(90. - normalizedDegree(ang)).ToSpecificType(ang.AngleType())
+}
+
+// Supplement returns 180° minus the angle normalized into [0°, 360°) in the angle type of the angle
+func Supplement(ang angle.Angle) angle.Angle {
+	return angle.NewDegreeFromFloat(180. - normalizedDegree(ang)).ToSpecificType(ang.AngleType())
+}
@@ -0,0 +1,41 @@
+package angleUtil
+
+import (
+	"math"
+	"testing"
+
+	"github.com/naufalfmm/angle"
+)
+
+func TestComplementSupplement(t *testing.T) {
+	tests := []struct {
+		name           string
+		ang            angle.Angle
+		wantComplement float64
+		wantSupplement float64
+	}{
+		{"30", angle.NewDegreeFromFloat(30.), 60., 150.},
+		{"120", angle.NewDegreeFromFloat(120.), -30., 60.},
+		{"beyond a rotation", angle.NewDegreeFromFloat(390.), 60., 150.},
+		{"negative", angle.NewDegreeFromFloat(-30.), -240., -150.},
+		{"dms", angle.NewFromDegreeMinuteSecond(18., 30., 0.), 71.5, 161.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			complement, supplement := Complement(tt.ang), Supplement(tt.ang)
+
+			if got := complement.ToDegree().ToFloat(); math.Abs(got-tt.wantComplement) > tolerance {
+				t.Errorf("Complement() = %v, want %v°", got, tt.wantComplement)
+			}
+
+			if got := supplement.ToDegree().ToFloat(); math.Abs(got-tt.wantSupplement) > tolerance {
+				t.Errorf("Supplement() = %v, want %v°", got, tt.wantSupplement)
+			}
+
+			if complement.AngleType() != tt.ang.AngleType() || supplement.AngleType() != tt.ang.AngleType() {
+				t.Errorf("angle types = %v and %v, want %v", complement.AngleType(), supplement.AngleType(), tt.ang.AngleType())
+			}
+		})
+	}
+}
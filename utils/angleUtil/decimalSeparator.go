@@ -0,0 +1,20 @@
+package angleUtil
+
+import (
+	"strings"
+
+	"github.com/naufalfmm/angle"
+)
+
+// DefaultDecimalSeparator is the decimal separator of the angle String
+const DefaultDecimalSeparator = "."
+
+// StringWithDecimalSeparator formats the angle like its String with the given decimal separator, e.g. "6,5°" for the comma.
+// The empty separator keeps the default one.
+func StringWithDecimalSeparator(ang angle.Angle, separator string) string {
+	if separator == "" || separator == DefaultDecimalSeparator {
+		return ang.String()
+	}
+
+	return strings.ReplaceAll(ang.String(), DefaultDecimalSeparator, separator)
+}
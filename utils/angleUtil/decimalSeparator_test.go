@@ -0,0 +1,31 @@
+package angleUtil
+
+import (
+	"testing"
+
+	"github.com/naufalfmm/angle"
+)
+
+func TestStringWithDecimalSeparator(t *testing.T) {
+	tests := []struct {
+		name      string
+		ang       angle.Angle
+		separator string
+		want      string
+	}{
+		{"comma", angle.NewDegreeFromFloat(6.5), ",", "6,5°"},
+		{"dot", angle.NewDegreeFromFloat(6.5), ".", "6.5°"},
+		{"empty", angle.NewDegreeFromFloat(6.5), "", "6.5°"},
+		{"negative comma", angle.NewDegreeFromFloat(-6.5), ",", "-6,5°"},
+		{"dms comma", angle.NewFromDegreeMinuteSecond(6., 12., 45.5), ",", "6°12'45,5\""},
+		{"whole", angle.NewDegreeFromFloat(18.), ",", "18°"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StringWithDecimalSeparator(tt.ang, tt.separator); got != tt.want {
+				t.Errorf("StringWithDecimalSeparator() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
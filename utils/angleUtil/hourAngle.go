@@ -0,0 +1,22 @@
+package angleUtil
+
+import (
+	"math"
+	"time"
+
+	"github.com/naufalfmm/angle"
+)
+
+// ToHourDuration converts the signed decimal degree of the hour angle to the duration at 15° an hour, e.g. 7.5° is 30 minutes
+func ToHourDuration(ang angle.Angle) time.Duration {
+	return time.Duration(ang.ToDegree().ToFloat() / 15. * float64(time.Hour))
+}
+
+// DivMod divides the signed decimal degree of the angle by the divisor, returning the integer quotient truncated toward zero
+// and the remainder carrying the sign of the angle, e.g. 47° by 15 is 3 and 2°
+func DivMod(ang angle.Angle, divisor float64) (int, angle.Angle) {
+	deg := ang.ToDegree().ToFloat()
+	quotient := math.Trunc(deg / divisor)
+
+	return int(quotient), angle.NewDegreeFromFloat(deg - quotient*divisor).ToSpecificType(ang.AngleType())
+}
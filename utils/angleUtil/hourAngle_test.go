@@ -0,0 +1,62 @@
+package angleUtil
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/naufalfmm/angle"
+)
+
+const tolerance = 1e-9
+
+func TestToHourDuration(t *testing.T) {
+	tests := []struct {
+		name string
+		ang  angle.Angle
+		want time.Duration
+	}{
+		{"15", angle.NewDegreeFromFloat(15.), time.Hour},
+		{"7.5", angle.NewDegreeFromFloat(7.5), 30 * time.Minute},
+		{"negative", angle.NewDegreeFromFloat(-22.5), -90 * time.Minute},
+		{"dms", angle.NewFromDegreeMinuteSecond(0., 15., 0.), time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToHourDuration(tt.ang); got != tt.want {
+				t.Errorf("ToHourDuration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDivMod(t *testing.T) {
+	tests := []struct {
+		name          string
+		ang           angle.Angle
+		divisor       float64
+		wantQuotient  int
+		wantRemainder float64
+	}{
+		{"47 by 15", angle.NewDegreeFromFloat(47.), 15., 3, 2.},
+		{"negative 47 by 15", angle.NewDegreeFromFloat(-47.), 15., -3, -2.},
+		{"exact multiple", angle.NewDegreeFromFloat(45.), 15., 3, 0.},
+		{"below the divisor", angle.NewDegreeFromFloat(7.5), 15., 0, 7.5},
+		{"dms", angle.NewFromDegreeMinuteSecond(47., 30., 0.), 15., 3, 2.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quotient, remainder := DivMod(tt.ang, tt.divisor)
+
+			if quotient != tt.wantQuotient {
+				t.Errorf("quotient = %d, want %d", quotient, tt.wantQuotient)
+			}
+
+			if got := remainder.ToDegree().ToFloat(); math.Abs(got-tt.wantRemainder) > tolerance {
+				t.Errorf("remainder = %v, want %v°", got, tt.wantRemainder)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package angleUtil
+
+import (
+	"github.com/naufalfmm/angle"
+	"github.com/naufalfmm/angle/angleType"
+)
+
+// SafeString formats the degree minute second angle like its String with the minute and second carried into [0, 60),
+// so the floating noise of 59.9999999" is printed as the next minute. The decimal angle is formatted by its String.
+func SafeString(ang angle.Angle) string {
+	if ang.AngleType() != angleType.DegreeMinuteSecond {
+		return ang.String()
+	}
+
+	return newSignedDMS(dmsParts(ang)).String()
+}
@@ -0,0 +1,30 @@
+package angleUtil
+
+import (
+	"testing"
+
+	"github.com/naufalfmm/angle"
+)
+
+func TestSafeString(t *testing.T) {
+	tests := []struct {
+		name string
+		ang  angle.Angle
+		want string
+	}{
+		{"second carry", angle.NewFromDegreeMinuteSecond(6., 12., 59.9999999), "6°13'0\""},
+		{"minute and second carry", angle.NewFromDegreeMinuteSecond(6., 59., 59.9999999), "7°0'0\""},
+		{"minute noise", angle.NewFromDegreeMinuteSecond(106., 47.9999999999, 0.), "106°48'0\""},
+		{"negative", angle.NewFromDegreeMinuteSecond(-6., 12., 59.9999999), "-6°13'0\""},
+		{"clean", angle.NewFromDegreeMinuteSecond(6., 12., 45.5), "6°12'45.5\""},
+		{"decimal", angle.NewDegreeFromFloat(6.5), "6.5°"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SafeString(tt.ang); got != tt.want {
+				t.Errorf("SafeString() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package angleUtil
+
+import (
+	"math"
+
+	"github.com/naufalfmm/angle"
+)
+
+// NewDegreeFromFloatSigned builds the decimal degree angle of the magnitude of the value with the sign forced by neg,
+// so the zero can be flagged negative, e.g. for a place on the equator taken as southern
+func NewDegreeFromFloatSigned(value float64, neg bool) angle.Angle {
+	ang := angle.NewDegreeFromFloat(math.Abs(value))
+	if neg {
+		return ang.Neg()
+	}
+
+	return ang
+}
@@ -0,0 +1,16 @@
+package angleUtil
+
+import "github.com/naufalfmm/angle"
+
+// SignedString formats the angle like its String with the leading + for the positive angle, leaving the zero angle unsigned
+func SignedString(ang angle.Angle) string {
+	if ang.IsZero() {
+		return ang.Abs().String()
+	}
+
+	if ang.IsNegative() {
+		return ang.String()
+	}
+
+	return "+" + ang.String()
+}
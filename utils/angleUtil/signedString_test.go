@@ -0,0 +1,29 @@
+package angleUtil
+
+import (
+	"testing"
+
+	"github.com/naufalfmm/angle"
+)
+
+func TestSignedString(t *testing.T) {
+	tests := []struct {
+		name string
+		ang  angle.Angle
+		want string
+	}{
+		{"positive", angle.NewDegreeFromFloat(6.5), "+6.5°"},
+		{"negative", angle.NewDegreeFromFloat(-6.5), "-6.5°"},
+		{"zero", angle.NewDegreeFromFloat(0.), "0°"},
+		{"negative zero", angle.NewDegreeFromFloat(0.).Neg(), "0°"},
+		{"dms", angle.NewFromDegreeMinuteSecond(106., 48., 0.), "+106°48'0\""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SignedString(tt.ang); got != tt.want {
+				t.Errorf("SignedString() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package angleUtil
+
+import "testing"
+
+func TestNewDegreeFromFloatSigned(t *testing.T) {
+	tests := []struct {
+		name         string
+		value        float64
+		neg          bool
+		wantDegree   float64
+		wantNegative bool
+	}{
+		{"negative zero", 0., true, 0., true},
+		{"positive zero", 0., false, 0., false},
+		{"forced negative", 6.5, true, -6.5, true},
+		{"forced positive", -6.5, false, 6.5, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ang := NewDegreeFromFloatSigned(tt.value, tt.neg)
+
+			if ang.IsNegative() != tt.wantNegative {
+				t.Errorf("IsNegative() = %v, want %v", ang.IsNegative(), tt.wantNegative)
+			}
+
+			if got := ang.ToDegree().ToFloat(); got != tt.wantDegree {
+				t.Errorf("degree = %v, want %v", got, tt.wantDegree)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package angleUtil
+
+import (
+	"math"
+
+	"github.com/naufalfmm/angle"
+)
+
+// secondPrecision absorbs the floating noise of the decimal degree, so 106.8° is 106°48'0" rather than 106°47'59.999"
+const secondPrecision = 1e6
+
+// dmsParts splits the magnitude of the angle into the degree, minute, and second with the minute and second carried into [0, 60)
+func dmsParts(ang angle.Angle) (bool, float64, float64, float64) {
+	deg := ang.ToDegree().ToFloat()
+	totalSecond := math.Round(math.Abs(deg)*3600.*secondPrecision) / secondPrecision
+
+	degree := math.Floor(totalSecond / 3600.)
+	minute := math.Floor((totalSecond - degree*3600.) / 60.)
+	second := totalSecond - degree*3600. - minute*60.
+
+	return deg < 0, degree, minute, second
+}
+
+// newSignedDMS builds the degree minute second angle of the parts with the negative flag
+func newSignedDMS(neg bool, degree, minute, second float64) angle.Angle {
+	ang := angle.NewFromDegreeMinuteSecond(degree, minute, second)
+	if neg {
+		return ang.Neg()
+	}
+
+	return ang
+}
+
+// TruncateSecond returns the degree minute second angle without the fractional seconds
+func TruncateSecond(ang angle.Angle) angle.Angle {
+	neg, degree, minute, second := dmsParts(ang)
+	return newSignedDMS(neg, degree, minute, math.Floor(second))
+}
+
+// TruncateMinute returns the degree minute second angle without the seconds
+func TruncateMinute(ang angle.Angle) angle.Angle {
+	neg, degree, minute, _ := dmsParts(ang)
+	return newSignedDMS(neg, degree, minute, 0.)
+}
+
+// TruncateDegree returns the degree minute second angle of the whole degrees
+func TruncateDegree(ang angle.Angle) angle.Angle {
+	neg, degree, _, _ := dmsParts(ang)
+	return newSignedDMS(neg, degree, 0., 0.)
+}
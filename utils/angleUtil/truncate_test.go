@@ -0,0 +1,35 @@
+package angleUtil
+
+import (
+	"testing"
+
+	"github.com/naufalfmm/angle"
+)
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name                                              string
+		ang                                               angle.Angle
+		wantTruncSecond, wantTruncMinute, wantTruncDegree string
+	}{
+		{"dms", angle.NewFromDegreeMinuteSecond(6., 12., 45.75), "6°12'45\"", "6°12'0\"", "6°0'0\""},
+		{"decimal", angle.NewDegreeFromFloat(106.8), "106°48'0\"", "106°48'0\"", "106°0'0\""},
+		{"negative", angle.NewDegreeFromFloat(-6.2125), "-6°12'45\"", "-6°12'0\"", "-6°0'0\""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TruncateSecond(tt.ang).String(); got != tt.wantTruncSecond {
+				t.Errorf("TruncateSecond() = %s, want %s", got, tt.wantTruncSecond)
+			}
+
+			if got := TruncateMinute(tt.ang).String(); got != tt.wantTruncMinute {
+				t.Errorf("TruncateMinute() = %s, want %s", got, tt.wantTruncMinute)
+			}
+
+			if got := TruncateDegree(tt.ang).String(); got != tt.wantTruncDegree {
+				t.Errorf("TruncateDegree() = %s, want %s", got, tt.wantTruncDegree)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package coordinate
+
+import (
+	"math"
+
+	"github.com/naufalfmm/angle"
+)
+
+// GeocentricLatitude converts the geodetic latitude into the geocentric latitude on the WGS84 ellipsoid, tan(ψ) = (1 - f)² tan(φ)
+func GeocentricLatitude(latitude angle.Angle) angle.Angle {
+	lat := latitude.ToDegree().ToFloat() * math.Pi / 180.
+
+	return angle.NewDegreeFromFloat(math.Atan((1.-wgs84Flattening)*(1.-wgs84Flattening)*math.Tan(lat)) * 180. / math.Pi)
+}
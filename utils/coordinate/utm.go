@@ -0,0 +1,76 @@
+package coordinate
+
+import (
+	"math"
+	"unicode"
+
+	"github.com/naufalfmm/angle"
+	"github.com/naufalfmm/moslem-salat-times/err"
+)
+
+const (
+	wgs84SemiMajorAxis = 6378137.
+	wgs84Flattening    = 1. / 298.257223563
+
+	utmScaleFactor    = 0.9996
+	utmFalseEasting   = 500000.
+	utmFalseNorthing  = 10000000.
+	utmZoneWidth      = 6.
+	utmMaxZone        = 60
+	utmMaxEasting     = 1000000.
+	utmMaxNorthing    = 10000000.
+	utmFirstMeridian  = -180.
+	utmMeridianOffset = 3.
+)
+
+// FromUTM converts the WGS84 UTM coordinate into the latitude and longitude by the inverse transverse mercator series of Snyder.
+// The hemisphere is N or S.
+func FromUTM(zone int, hemisphere rune, easting, northing float64) (angle.Angle, angle.Angle, error) {
+	if zone < 1 || zone > utmMaxZone {
+		return angle.Angle{}, angle.Angle{}, err.ErrInvalidUTMCoordinate
+	}
+
+	if easting <= 0 || easting >= utmMaxEasting || northing < 0 || northing > utmMaxNorthing {
+		return angle.Angle{}, angle.Angle{}, err.ErrInvalidUTMCoordinate
+	}
+
+	switch unicode.ToUpper(hemisphere) {
+	case 'N':
+	case 'S':
+		northing -= utmFalseNorthing
+	default:
+		return angle.Angle{}, angle.Angle{}, err.ErrInvalidUTMCoordinate
+	}
+
+	e2 := wgs84Flattening * (2. - wgs84Flattening)
+	ep2 := e2 / (1. - e2)
+	e1 := (1. - math.Sqrt(1.-e2)) / (1. + math.Sqrt(1.-e2))
+
+	mu := northing / utmScaleFactor / (wgs84SemiMajorAxis * (1. - e2/4. - 3.*e2*e2/64. - 5.*e2*e2*e2/256.))
+
+	footLat := mu +
+		(3.*e1/2.-27.*math.Pow(e1, 3)/32.)*math.Sin(2.*mu) +
+		(21.*e1*e1/16.-55.*math.Pow(e1, 4)/32.)*math.Sin(4.*mu) +
+		(151.*math.Pow(e1, 3)/96.)*math.Sin(6.*mu) +
+		(1097.*math.Pow(e1, 4)/512.)*math.Sin(8.*mu)
+
+	sinFootLat, cosFootLat, tanFootLat := math.Sin(footLat), math.Cos(footLat), math.Tan(footLat)
+
+	n1 := wgs84SemiMajorAxis / math.Sqrt(1.-e2*sinFootLat*sinFootLat)
+	r1 := wgs84SemiMajorAxis * (1. - e2) / math.Pow(1.-e2*sinFootLat*sinFootLat, 1.5)
+	t1 := tanFootLat * tanFootLat
+	c1 := ep2 * cosFootLat * cosFootLat
+	d := (easting - utmFalseEasting) / (n1 * utmScaleFactor)
+
+	lat := footLat - (n1*tanFootLat/r1)*(d*d/2.-
+		(5.+3.*t1+10.*c1-4.*c1*c1-9.*ep2)*math.Pow(d, 4)/24.+
+		(61.+90.*t1+298.*c1+45.*t1*t1-252.*ep2-3.*c1*c1)*math.Pow(d, 6)/720.)
+
+	long := (d -
+		(1.+2.*t1+c1)*math.Pow(d, 3)/6. +
+		(5.-2.*c1+28.*t1-3.*c1*c1+8.*ep2+24.*t1*t1)*math.Pow(d, 5)/120.) / cosFootLat
+
+	centralMeridian := utmFirstMeridian + float64(zone-1)*utmZoneWidth + utmMeridianOffset
+
+	return angle.NewDegreeFromFloat(lat * 180. / math.Pi), angle.NewDegreeFromFloat(centralMeridian + long*180./math.Pi), nil
+}
@@ -0,0 +1,54 @@
+package coordinate
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/naufalfmm/moslem-salat-times/err"
+)
+
+func TestFromUTM(t *testing.T) {
+	tests := []struct {
+		name       string
+		zone       int
+		hemisphere rune
+		easting    float64
+		northing   float64
+		latitude   float64
+		longitude  float64
+		wantErr    error
+	}{
+		{name: "jakarta", zone: 48, hemisphere: 'S', easting: 701000, northing: 9312000, latitude: -6.2211, longitude: 106.8167},
+		{name: "lowercase hemisphere", zone: 48, hemisphere: 's', easting: 701000, northing: 9312000, latitude: -6.2211, longitude: 106.8167},
+		{name: "central meridian on the equator", zone: 31, hemisphere: 'N', easting: 500000, northing: 0, latitude: 0, longitude: 3},
+		{name: "zone 0", zone: 0, hemisphere: 'S', easting: 701000, northing: 9312000, wantErr: err.ErrInvalidUTMCoordinate},
+		{name: "zone 61", zone: 61, hemisphere: 'S', easting: 701000, northing: 9312000, wantErr: err.ErrInvalidUTMCoordinate},
+		{name: "bad hemisphere", zone: 48, hemisphere: 'X', easting: 701000, northing: 9312000, wantErr: err.ErrInvalidUTMCoordinate},
+		{name: "easting out of range", zone: 48, hemisphere: 'S', easting: 1000000, northing: 9312000, wantErr: err.ErrInvalidUTMCoordinate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lat, long, convErr := FromUTM(tt.zone, tt.hemisphere, tt.easting, tt.northing)
+			if tt.wantErr != nil {
+				if !errors.Is(convErr, tt.wantErr) {
+					t.Fatalf("FromUTM() error = %v, want %v", convErr, tt.wantErr)
+				}
+				return
+			}
+
+			if convErr != nil {
+				t.Fatalf("FromUTM() error = %v", convErr)
+			}
+
+			if got := lat.ToDegree().ToFloat(); math.Abs(got-tt.latitude) > 1e-3 {
+				t.Errorf("FromUTM() latitude = %v°, want %v°", got, tt.latitude)
+			}
+
+			if got := long.ToDegree().ToFloat(); math.Abs(got-tt.longitude) > 1e-3 {
+				t.Errorf("FromUTM() longitude = %v°, want %v°", got, tt.longitude)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package coordinate
+
+import (
+	"math"
+
+	"github.com/naufalfmm/angle"
+)
+
+const (
+	maxLatitude  = 90.
+	maxLongitude = 180.
+)
+
+// IsValidLatitude reports whether the signed decimal degree of the latitude is within [-90°, 90°]
+func IsValidLatitude(latitude angle.Angle) bool {
+	return isWithin(latitude, maxLatitude)
+}
+
+// IsValidLongitude reports whether the signed decimal degree of the longitude is within [-180°, 180°]
+func IsValidLongitude(longitude angle.Angle) bool {
+	return isWithin(longitude, maxLongitude)
+}
+
+// isWithin rejects the zero value angle which carries no unit
+func isWithin(ang angle.Angle, max float64) bool {
+	if ang == (angle.Angle{}) {
+		return false
+	}
+
+	deg := ang.ToDegree().ToFloat()
+
+	return !math.IsNaN(deg) && math.Abs(deg) <= max
+}
@@ -0,0 +1,60 @@
+package gazetteer
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/naufalfmm/angle"
+	"github.com/naufalfmm/moslem-salat-times/err"
+)
+
+//go:embed cities.csv
+var citiesCSV string
+
+type City struct {
+	Name      string
+	Latitude  angle.Angle
+	Longitude angle.Angle
+	Timezone  string
+}
+
+var (
+	citiesOnce sync.Once
+	cities     map[string]City
+)
+
+func cityKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func loadCities() {
+	cities = map[string]City{}
+
+	records, _ := csv.NewReader(strings.NewReader(citiesCSV)).ReadAll()
+	for _, record := range records[1:] {
+		lat, _ := strconv.ParseFloat(record[1], 64)
+		long, _ := strconv.ParseFloat(record[2], 64)
+
+		cities[cityKey(record[0])] = City{
+			Name:      record[0],
+			Latitude:  angle.NewDegreeFromFloat(lat),
+			Longitude: angle.NewDegreeFromFloat(long),
+			Timezone:  record[3],
+		}
+	}
+}
+
+// Lookup finds the city of the bundled gazetteer by its case insensitive name
+func Lookup(name string) (City, error) {
+	citiesOnce.Do(loadCities)
+
+	city, ok := cities[cityKey(name)]
+	if !ok {
+		return City{}, err.ErrUnknownCity
+	}
+
+	return city, nil
+}
@@ -32,5 +32,5 @@ func GregorianToJulianUTC(timeDate time.Time) float64 {
 		b = 2.0 - a + math.Floor(a/4.0)
 	}
 
-	return 1720994.5 + math.Floor(365.25*year) + math.Floor(30.6001*(month+1)) + b + date + float64(timeDate.Hour())/24. + float64(timeDate.Minute())/24.*60.
+	return 1720994.5 + math.Floor(365.25*year) + math.Floor(30.6001*(month+1)) + b + date + float64(timeDate.Hour())/24. + float64(timeDate.Minute())/(24.*60.) + float64(timeDate.Second())/(24.*60.*60.)
 }
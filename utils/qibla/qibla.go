@@ -0,0 +1,72 @@
+package qibla
+
+import (
+	"math"
+
+	"github.com/naufalfmm/angle"
+	"github.com/naufalfmm/angle/trig"
+)
+
+// CalcQibla returns the great circle bearing from the coordinates to the reference, clockwise from the true north within [0°, 360°)
+func CalcQibla(lat, long, refLat, refLong angle.Angle) angle.Angle {
+	longDiff := refLong.Sub(long)
+
+	bearing := trig.Atan2(trig.Sin(longDiff), trig.Cos(lat)*trig.Tan(refLat)-trig.Sin(lat)*trig.Cos(longDiff)).ToDegree().ToFloat()
+
+	bearing = math.Mod(bearing, 360.)
+	if bearing < 0 {
+		bearing += 360.
+	}
+
+	return angle.NewDegreeFromFloat(bearing)
+}
+
+const meanAngleEpsilon = 1e-9
+
+// MeanAngle returns the circular mean of the bearings within [0°, 360°), the direction of the sum of their unit vectors, so the mean of 350° and 10° is 0°.
+// It returns NaN when there is no bearing or the bearings cancel out
+func MeanAngle(angles []angle.Angle) angle.Angle {
+	var sinSum, cosSum float64
+	for _, ang := range angles {
+		sinSum += trig.Sin(ang)
+		cosSum += trig.Cos(ang)
+	}
+
+	if len(angles) == 0 || math.Hypot(sinSum, cosSum) < meanAngleEpsilon*float64(len(angles)) {
+		return angle.NewDegreeFromFloat(math.NaN())
+	}
+
+	return angle.NewDegreeFromFloat(math.Mod(trig.Atan2(sinSum, cosSum).ToDegree().ToFloat()+360., 360.))
+}
+
+var (
+	compassPoints16 = []string{"N", "NNE", "NE", "ENE", "E", "ESE", "SE", "SSE", "S", "SSW", "SW", "WSW", "W", "WNW", "NW", "NNW"}
+	compassPoints32 = []string{
+		"N", "NbE", "NNE", "NEbN", "NE", "NEbE", "ENE", "EbN",
+		"E", "EbS", "ESE", "SEbE", "SE", "SEbS", "SSE", "SbE",
+		"S", "SbW", "SSW", "SWbS", "SW", "SWbW", "WSW", "WbS",
+		"W", "WbN", "WNW", "NWbW", "NW", "NWbN", "NNW", "NbW",
+	}
+)
+
+// CompassPoint returns the nearest of the 16 compass point labels of the bearing, e.g. WNW for 295°, or an empty string for NaN
+func CompassPoint(bearing angle.Angle) string {
+	return compassPoint(bearing, compassPoints16)
+}
+
+// CompassPoint32 returns the nearest of the 32 compass point labels of the bearing, e.g. NWbW for 303.75°, or an empty string for NaN
+func CompassPoint32(bearing angle.Angle) string {
+	return compassPoint(bearing, compassPoints32)
+}
+
+func compassPoint(bearing angle.Angle, points []string) string {
+	deg := bearing.ToDegree().ToFloat()
+	if math.IsNaN(deg) || math.IsInf(deg, 0) {
+		return ""
+	}
+
+	sector := 360. / float64(len(points))
+	index := int(math.Round(math.Mod(math.Mod(deg, 360.)+360., 360.)/sector)) % len(points)
+
+	return points[index]
+}
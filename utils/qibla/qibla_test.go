@@ -0,0 +1,33 @@
+package qibla
+
+import (
+	"math"
+	"testing"
+
+	"github.com/naufalfmm/angle"
+)
+
+func TestCalcQibla(t *testing.T) {
+	kaabaLatitude, kaabaLongitude := angle.NewDegreeFromFloat(21.4225), angle.NewDegreeFromFloat(39.8262)
+
+	tests := []struct {
+		name      string
+		latitude  float64
+		longitude float64
+		want      float64
+	}{
+		{"new york", 40.7128, -74.006, 58.48},
+		{"jakarta", -6.2088, 106.8456, 295.16},
+		{"london", 51.5074, -0.1278, 118.99},
+		{"due north of the kaaba", 30., 39.8262, 180.},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CalcQibla(angle.NewDegreeFromFloat(tt.latitude), angle.NewDegreeFromFloat(tt.longitude), kaabaLatitude, kaabaLongitude).ToDegree().ToFloat()
+			if math.Abs(got-tt.want) > 0.01 {
+				t.Errorf("CalcQibla() = %v°, want %v°", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/naufalfmm/angle/trig"
 )
 
+// CalcSalatHighAltitude lowers the angle factor by the horizon dip of 0.0347°√elevation, so a higher place reaches sunset later and sunrise earlier
 func CalcSalatHighAltitude(angleFactor, lat, dec angle.Angle, elev float64) angle.Angle {
-	return trig.Acos((trig.Sin(angleFactor.Neg().SubScalar(0.0347*math.Sqrt(elev))) - trig.Sin(lat)*trig.Sin(dec)) / (trig.Cos(lat) * trig.Cos(dec))).Div(15.)
+	return trig.Acos((trig.Sin(angle.NewDegreeFromFloat(-angleFactor.ToDegree().ToFloat()).SubScalar(0.0347*math.Sqrt(elev))) - trig.Sin(lat)*trig.Sin(dec)) / (trig.Cos(lat) * trig.Cos(dec))).Div(15.)
 }
@@ -0,0 +1,29 @@
+package salatHighAltitude
+
+import (
+	"math"
+	"testing"
+
+	"github.com/naufalfmm/angle"
+)
+
+func TestCalcSalatHighAltitudeSign(t *testing.T) {
+	tests := []struct {
+		name        string
+		angleFactor float64
+		want        float64
+	}{
+		{"below horizon", 0.833, 6. + 0.833/15.},
+		{"horizon", 0., 6.},
+		{"above horizon", -0.833, 6. - 0.833/15.},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CalcSalatHighAltitude(angle.NewDegreeFromFloat(tt.angleFactor), angle.NewDegreeFromFloat(0.), angle.NewDegreeFromFloat(0.), 0.).ToDegree().ToFloat()
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("CalcSalatHighAltitude() = %v h, want %v h", got, tt.want)
+			}
+		})
+	}
+}
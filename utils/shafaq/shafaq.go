@@ -0,0 +1,88 @@
+package shafaq
+
+import (
+	"math"
+	"time"
+
+	shafaqEnum "github.com/naufalfmm/moslem-salat-times/enum/shafaq"
+)
+
+const (
+	northernSolsticeOffset = 10
+	southernSolsticeOffset = 172
+
+	latitudeDivisor = 55.
+)
+
+// seasonCoefficients are the four seasonal anchors of the Moonsighting Committee in minutes after the sunset,
+// each as the base plus the factor times the absolute latitude over 55°
+var seasonCoefficients = map[shafaqEnum.Shafaq][4][2]float64{
+	shafaqEnum.General: {{75., 25.60}, {75., 2.050}, {75., -9.21}, {75., 6.14}},
+	shafaqEnum.Ahmar:   {{62., 17.40}, {62., -7.16}, {62., 5.12}, {62., 19.44}},
+	shafaqEnum.Abyad:   {{75., 25.60}, {75., 7.16}, {75., 36.84}, {75., 81.84}},
+}
+
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// daysSinceSolstice counts the days since the winter solstice of the hemisphere
+func daysSinceSolstice(date time.Time, latitude float64) int {
+	daysInYear := 365
+	southernOffset := southernSolsticeOffset
+	if isLeapYear(date.Year()) {
+		daysInYear++
+		southernOffset++
+	}
+
+	if latitude >= 0 {
+		days := date.YearDay() + northernSolsticeOffset
+		if days >= daysInYear {
+			days -= daysInYear
+		}
+
+		return days
+	}
+
+	days := date.YearDay() - southernOffset
+	if days < 0 {
+		days += daysInYear
+	}
+
+	return days
+}
+
+// SeasonAdjustedEveningTwilight returns the duration from the sunset to the isha by the shafaq of the Moonsighting Committee,
+// interpolated over the days since the winter solstice. It is meant for the latitude below 55°.
+func SeasonAdjustedEveningTwilight(latitude float64, date time.Time, shafaq shafaqEnum.Shafaq) time.Duration {
+	coefficients, ok := seasonCoefficients[shafaq]
+	if !ok {
+		coefficients = seasonCoefficients[shafaqEnum.General]
+	}
+
+	var anchors [4]float64
+	for i, coefficient := range coefficients {
+		anchors[i] = coefficient[0] + coefficient[1]/latitudeDivisor*math.Abs(latitude)
+	}
+	a, b, c, d := anchors[0], anchors[1], anchors[2], anchors[3]
+
+	dyy := float64(daysSinceSolstice(date, latitude))
+
+	var minutes float64
+	switch {
+	case dyy < 91:
+		minutes = a + (b-a)/91.*dyy
+	case dyy < 137:
+		minutes = b + (c-b)/46.*(dyy-91.)
+	case dyy < 183:
+		minutes = c + (d-c)/46.*(dyy-137.)
+	case dyy < 229:
+		minutes = d + (c-d)/46.*(dyy-183.)
+	case dyy < 275:
+		minutes = c + (b-c)/46.*(dyy-229.)
+	default:
+		minutes = b + (a-b)/91.*(dyy-275.)
+	}
+
+	return time.Duration(minutes * float64(time.Minute))
+}
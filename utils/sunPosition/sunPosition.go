@@ -34,7 +34,7 @@ func CalSunPosition(julianDay, timezone float64, longitude angle.Angle) SunPosit
 		equationOfTime = equationOfTime.SubScalar(360.)
 	}
 
-	SunTransitTime := longitude.Div(15.).Neg().Sub(equationOfTime.Mul(4.).Div(60.)).AddScalar(12.).AddScalar(timezone)
+	SunTransitTime := angle.NewDegreeFromFloat(12.).Sub(longitude.Div(15.)).Sub(equationOfTime.Mul(4.).Div(60.)).AddScalar(timezone)
 
 	return SunPosition{
 		JulianDate:     julianDate,
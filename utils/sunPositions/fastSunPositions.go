@@ -0,0 +1,45 @@
+package sunPositions
+
+import (
+	"math"
+	"time"
+
+	"github.com/naufalfmm/angle"
+	"github.com/naufalfmm/moslem-salat-times/consts"
+	"github.com/naufalfmm/moslem-salat-times/utils/julian"
+)
+
+const radianPerDegree = math.Pi / 180.
+
+// calFastSunPositionByDate computes the sun position by the same almanac formulas in float64 without the angle conversions.
+// The second harmonic of the equation of center and the obliquity drift are dropped, which moves the times by a few seconds.
+func calFastSunPositionByDate(date time.Time, loc *time.Location, longitude angle.Angle) SunPosition {
+	dateSunPos := SunPosition{}
+
+	dateSunPos.Date = time.Date(date.Year(), date.Month(), date.Day(), 12, 0, 0, 0, loc)
+	dateSunPos.JulianDay = julian.GregorianToJulianUTC(dateSunPos.Date)
+	dateSunPos.JulianDate = dateSunPos.JulianDay - 2451545.
+
+	meanAnomaly := math.Mod(357.529+0.98560028*dateSunPos.JulianDate, 360.)
+	meanLongSun := math.Mod(280.459+0.98564736*dateSunPos.JulianDate, 360.)
+	eclipticLong := (meanLongSun + 1.915*math.Sin(meanAnomaly*radianPerDegree)) * radianPerDegree
+	obliquity := 23.439 * radianPerDegree
+
+	rightAscension := math.Atan2(math.Cos(obliquity)*math.Sin(eclipticLong), math.Cos(eclipticLong)) / radianPerDegree
+
+	equationOfTime := math.Mod(meanLongSun-rightAscension, 360.)
+	if equationOfTime > 180. {
+		equationOfTime -= 360.
+	} else if equationOfTime < -180. {
+		equationOfTime += 360.
+	}
+
+	dateSunPos.Declination = angle.NewRadianFromFloat(math.Asin(math.Sin(obliquity) * math.Sin(eclipticLong)))
+	dateSunPos.EquationOfTime = angle.NewDegreeFromFloat(equationOfTime)
+
+	_, offset := dateSunPos.Date.Zone()
+
+	dateSunPos.SunTransitTime = angle.NewDegreeFromFloat(12. - normalizedDegree(longitude)/15. - equationOfTime*4./60. + float64(offset)/consts.OffsetTimezone)
+
+	return dateSunPos
+}
@@ -0,0 +1,72 @@
+package sunPositions
+
+import (
+	"time"
+
+	"github.com/naufalfmm/angle"
+	"github.com/naufalfmm/moslem-salat-times/consts"
+)
+
+// SolarOverride fixes the declination and the equation of time of every date, e.g. to simulate a hypothetical sun
+type SolarOverride struct {
+	Declination    angle.Angle
+	EquationOfTime time.Duration
+}
+
+// NewFromOverride builds the sun positions of the date range on the overridden solar parameters instead of computing them
+func NewFromOverride(dateStart, dateEnd time.Time, loc *time.Location, longitude angle.Angle, override SolarOverride) SunPositions {
+	days := countDays(dateStart, dateEnd)
+	if days < 0 {
+		days = 0
+	}
+
+	dateSunPoss := make(SunPositions, days)
+	for i := 0; i < days; i++ {
+		date := dateStart.AddDate(0, 0, i)
+
+		dateSunPoss[i] = newSuppliedSunPosition(time.Date(date.Year(), date.Month(), date.Day(), 12, 0, 0, 0, loc), longitude, override.Declination, override.EquationOfTime)
+	}
+
+	return dateSunPoss
+}
+
+// Ephemeris supplies the declination and the equation of time of the sun at the noon of the date
+type Ephemeris func(date time.Time) (angle.Angle, time.Duration, error)
+
+// NewFromEphemeris builds the sun positions of the date range on the solar parameters supplied by the ephemeris instead of computing them
+func NewFromEphemeris(dateStart, dateEnd time.Time, loc *time.Location, longitude angle.Angle, ephemeris Ephemeris) (SunPositions, error) {
+	days := countDays(dateStart, dateEnd)
+	if days < 0 {
+		days = 0
+	}
+
+	dateSunPoss := make(SunPositions, days)
+	for i := 0; i < days; i++ {
+		date := dateStart.AddDate(0, 0, i)
+		noon := time.Date(date.Year(), date.Month(), date.Day(), 12, 0, 0, 0, loc)
+
+		declination, equationOfTime, err := ephemeris(noon)
+		if err != nil {
+			return nil, err
+		}
+
+		dateSunPoss[i] = newSuppliedSunPosition(noon, longitude, declination, equationOfTime)
+	}
+
+	return dateSunPoss, nil
+}
+
+func newSuppliedSunPosition(noon time.Time, longitude, declination angle.Angle, equationOfTime time.Duration) SunPosition {
+	equationOfTimeHour := equationOfTime.Hours()
+
+	dateSunPos := SunPosition{}
+	dateSunPos.Date = noon
+	dateSunPos.Declination = declination
+	dateSunPos.EquationOfTime = angle.NewDegreeFromFloat(equationOfTimeHour * 15.)
+
+	_, offset := dateSunPos.Date.Zone()
+
+	dateSunPos.SunTransitTime = angle.NewDegreeFromFloat(12. - normalizedDegree(longitude)/15. - equationOfTimeHour + float64(offset)/consts.OffsetTimezone)
+
+	return dateSunPos
+}
@@ -1,11 +1,13 @@
 package sunPositions
 
 import (
+	"sync"
 	"time"
 
 	"github.com/naufalfmm/angle"
 	"github.com/naufalfmm/angle/trig"
 	"github.com/naufalfmm/moslem-salat-times/consts"
+	accuracyModeEnum "github.com/naufalfmm/moslem-salat-times/enum/accuracyMode"
 	"github.com/naufalfmm/moslem-salat-times/utils/julian"
 )
 
@@ -28,20 +30,91 @@ type (
 	}
 
 	SunPositions []SunPosition
+
+	cacheKey struct {
+		year      int
+		month     time.Month
+		day       int
+		loc       *time.Location
+		longitude float64
+		mode      accuracyModeEnum.AccuracyMode
+	}
 )
 
-func NewFromDateRange(dateStart, dateEnd time.Time, loc *time.Location, longitude angle.Angle) SunPositions {
-	dateSunPoss := make(SunPositions, int(dateEnd.Sub(dateStart).Hours()/24.)+1)
+const maxCacheSize = 4096
+
+var (
+	cacheMu sync.Mutex
+	cache   = map[cacheKey]SunPosition{}
+)
+
+// countDays counts the calendar days of the inclusive range, so a daylight saving shift does not drop a day
+func countDays(dateStart, dateEnd time.Time) int {
+	start := time.Date(dateStart.Year(), dateStart.Month(), dateStart.Day(), 0, 0, 0, 0, time.UTC)
+	end := time.Date(dateEnd.Year(), dateEnd.Month(), dateEnd.Day(), 0, 0, 0, 0, time.UTC)
+
+	return int(end.Sub(start).Hours()/24.) + 1
+}
 
-	for i := 0; i < int(dateEnd.Sub(dateStart).Hours()/24.)+1; i++ {
+func NewFromDateRange(dateStart, dateEnd time.Time, loc *time.Location, longitude angle.Angle, mode accuracyModeEnum.AccuracyMode) SunPositions {
+	days := countDays(dateStart, dateEnd)
+	if days < 0 {
+		days = 0
+	}
+
+	dateSunPoss := make(SunPositions, days)
+
+	for i := 0; i < days; i++ {
 		date := dateStart.AddDate(0, 0, i)
 
-		dateSunPoss[i] = calSunPositionByDate(date, loc, longitude)
+		dateSunPoss[i] = cachedSunPositionByDate(date, loc, longitude, mode)
 	}
 
 	return dateSunPoss
 }
 
+func normalizedDegree(ang angle.Angle) float64 {
+	if ang.IsZero() {
+		return 0
+	}
+
+	return ang.ToDecimal().ToDegree().ToFloat()
+}
+
+func cachedSunPositionByDate(date time.Time, loc *time.Location, longitude angle.Angle, mode accuracyModeEnum.AccuracyMode) SunPosition {
+	// longitudes are compared by their normalized decimal degree so 106.8° and 106°48'0" share an entry
+	key := cacheKey{
+		year:      date.Year(),
+		month:     date.Month(),
+		day:       date.Day(),
+		loc:       loc,
+		longitude: normalizedDegree(longitude),
+		mode:      mode,
+	}
+
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+
+	if sunPos, ok := cache[key]; ok {
+		return sunPos
+	}
+
+	if len(cache) >= maxCacheSize {
+		cache = map[cacheKey]SunPosition{}
+	}
+
+	var sunPos SunPosition
+	if mode == accuracyModeEnum.Fast {
+		sunPos = calFastSunPositionByDate(date, loc, longitude)
+	} else {
+		sunPos = calSunPositionByDate(date, loc, longitude)
+	}
+
+	cache[key] = sunPos
+
+	return sunPos
+}
+
 func calSunPositionByDate(date time.Time, loc *time.Location, longitude angle.Angle) SunPosition {
 	dateSunPos := SunPosition{}
 
@@ -61,9 +134,9 @@ func calSunPositionByDate(date time.Time, loc *time.Location, longitude angle.An
 		dateSunPos.EquationOfTime = dateSunPos.EquationOfTime.SubScalar(360.)
 	}
 
-	_, offset := time.Now().In(loc).Zone()
+	_, offset := dateSunPos.Date.Zone()
 
-	dateSunPos.SunTransitTime = longitude.Div(15.).Neg().Sub(dateSunPos.EquationOfTime.Mul(4.).Div(60.)).AddScalar(12.).AddScalar(float64(offset) / consts.OffsetTimezone)
+	dateSunPos.SunTransitTime = angle.NewDegreeFromFloat(12.).Sub(longitude.Div(15.)).Sub(dateSunPos.EquationOfTime.Mul(4.).Div(60.)).AddScalar(float64(offset) / consts.OffsetTimezone)
 
 	return dateSunPos
 }
@@ -0,0 +1,91 @@
+package sunPositions
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/naufalfmm/angle"
+	accuracyModeEnum "github.com/naufalfmm/moslem-salat-times/enum/accuracyMode"
+)
+
+func TestCalSunPositionByDateTransitLongitude(t *testing.T) {
+	date := time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		longitude float64
+		transit   float64
+	}{
+		{"new york", -74.006, 17. + 3./60.},
+		{"greenwich", 0., 12. + 7.5/60.},
+		{"jakarta", 106.845, 4. + 60./60.},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sunPos := calSunPositionByDate(date, time.UTC, angle.NewDegreeFromFloat(tt.longitude))
+
+			if transit := sunPos.SunTransitTime.ToDegree().ToFloat(); math.Abs(transit-tt.transit) > 2./60. {
+				t.Errorf("transit = %.4f h, want %.4f h within 2 minutes", transit, tt.transit)
+			}
+		})
+	}
+}
+
+func resetCache() {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+
+	cache = map[cacheKey]SunPosition{}
+}
+
+func TestCachedSunPositionByDateKey(t *testing.T) {
+	resetCache()
+
+	date := time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC)
+	jakarta := time.FixedZone("0700", 7*60*60)
+
+	decimal := cachedSunPositionByDate(date, time.UTC, angle.NewDegreeFromFloat(106.8), accuracyModeEnum.Precise)
+	dms := cachedSunPositionByDate(date, time.UTC, angle.NewFromDegreeMinuteSecond(106., 48., 0.), accuracyModeEnum.Precise)
+	if len(cache) != 1 {
+		t.Errorf("cache size = %d after the equivalent decimal and DMS longitudes, want 1", len(cache))
+	}
+
+	if decimal.SunTransitTime.ToDegree().ToFloat() != dms.SunTransitTime.ToDegree().ToFloat() {
+		t.Errorf("transit of the DMS longitude = %v, want %v", dms.SunTransitTime, decimal.SunTransitTime)
+	}
+
+	west := cachedSunPositionByDate(date, time.UTC, angle.NewDegreeFromFloat(-106.8), accuracyModeEnum.Precise)
+	if west.SunTransitTime.ToDegree().ToFloat() == decimal.SunTransitTime.ToDegree().ToFloat() {
+		t.Errorf("transit of the other longitude reused the cached %v", decimal.SunTransitTime)
+	}
+
+	local := cachedSunPositionByDate(date, jakarta, angle.NewDegreeFromFloat(106.8), accuracyModeEnum.Precise)
+	if local.SunTransitTime.ToDegree().ToFloat() == decimal.SunTransitTime.ToDegree().ToFloat() {
+		t.Errorf("transit of the other location reused the cached %v", decimal.SunTransitTime)
+	}
+
+	if len(cache) != 3 {
+		t.Errorf("cache size = %d after the other longitude and location, want 3", len(cache))
+	}
+}
+
+func BenchmarkNewFromDateRange(b *testing.B) {
+	dateStart := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	dateEnd := time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)
+	longitude := angle.NewDegreeFromFloat(106.845)
+
+	for _, mode := range []accuracyModeEnum.AccuracyMode{accuracyModeEnum.Precise, accuracyModeEnum.Fast} {
+		b.Run(mode.Code(), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				resetCache()
+				b.StartTimer()
+
+				NewFromDateRange(dateStart, dateEnd, time.UTC, longitude, mode)
+			}
+		})
+	}
+}
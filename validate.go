@@ -0,0 +1,25 @@
+package moslemSalatTimes
+
+import (
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	"github.com/naufalfmm/moslem-salat-times/option"
+)
+
+// ValidateAll validates every option against the salats and returns the first error of each option, nil for the valid one
+func ValidateAll(opts []option.Option, salats []salatEnum.Salat) []error {
+	if len(salats) == 0 {
+		salats = []salatEnum.Salat{0}
+	}
+
+	errs := make([]error, len(opts))
+	for i, opt := range opts {
+		for _, salat := range salats {
+			if err := opt.Clone().ValidateBySalat(salat); err != nil {
+				errs[i] = err
+				break
+			}
+		}
+	}
+
+	return errs
+}
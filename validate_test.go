@@ -0,0 +1,50 @@
+package moslemSalatTimes
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/naufalfmm/angle"
+	salatEnum "github.com/naufalfmm/moslem-salat-times/enum/salat"
+	"github.com/naufalfmm/moslem-salat-times/err"
+	"github.com/naufalfmm/moslem-salat-times/option"
+	"github.com/naufalfmm/moslem-salat-times/schedule"
+)
+
+func TestValidateAll(t *testing.T) {
+	date := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	opts := []option.Option{
+		newTestOption(-6.2, 106.8167, time.UTC).SetDateRange(date, date),
+		newTestOption(-6.2, 106.8167, time.UTC),
+		(&schedule.Option{}).SetDateRange(date, date),
+		(&schedule.Option{}).SetLatitudeLongitude(angle.NewDegreeFromFloat(21.4225), angle.NewDegreeFromFloat(39.8262)).SetDateRange(date, date),
+		newTestOption(51.5074, -0.1278, time.UTC).SetDateRange(date, date),
+	}
+
+	tests := []struct {
+		name   string
+		salats []salatEnum.Salat
+		want   []error
+	}{
+		{"no salat", nil, []error{nil, err.ErrDateMissing, err.ErrLatitudeMissing, nil, nil}},
+		{"fajr and asr", []salatEnum.Salat{salatEnum.Fajr, salatEnum.Asr}, []error{nil, err.ErrDateMissing, err.ErrLatitudeMissing, err.ErrFajrZenithMissing, nil}},
+		{"asr", []salatEnum.Salat{salatEnum.Asr}, []error{nil, err.ErrDateMissing, err.ErrLatitudeMissing, err.ErrMazhabMissing, nil}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateAll(opts, tt.salats)
+			if len(errs) != len(opts) {
+				t.Fatalf("ValidateAll() = %d errors, want %d", len(errs), len(opts))
+			}
+
+			for i, validateErr := range errs {
+				if !errors.Is(validateErr, tt.want[i]) {
+					t.Errorf("ValidateAll()[%d] = %v, want %v", i, validateErr, tt.want[i])
+				}
+			}
+		})
+	}
+}